@@ -0,0 +1,464 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/tenderly/tenderly-trace/source"
+	"github.com/tenderly/tenderly-trace/source/project"
+	"github.com/tenderly/tenderly-trace/tenderly"
+	"github.com/tenderly/tenderly-trace/tenderly/calltree"
+	"github.com/tenderly/tenderly-trace/tenderly/debugger"
+	"github.com/tenderly/tenderly-trace/tenderly/debugger/dap"
+)
+
+const defaultRpc = "http://127.0.0.1:8545"
+
+// usageError is returned for invalid command lines. An empty message means
+// the flag package already explained the problem.
+type usageError struct {
+	message string
+}
+
+func (e usageError) Error() string {
+	return e.message
+}
+
+// options are the flags shared by the commands.
+type options struct {
+	rpc     string
+	project string
+	format  string
+	tracer  string
+	depth   int
+	color   bool
+}
+
+func (o *options) register(flags *flag.FlagSet, output bool) {
+	flags.StringVar(&o.rpc, "rpc", defaultRpc, "Ethereum RPC server to fetch the chain state from")
+	flags.StringVar(&o.project, "project", "", "project holding the contract artifacts, the working directory if it holds one by default")
+	if output {
+		flags.StringVar(&o.format, "format", "json", "output format: json, pretty or text")
+		flags.StringVar(&o.tracer, "tracer", "", "name or code of the tracer, "+tenderly.DefaultTracer+" by default")
+		flags.IntVar(&o.depth, "depth", 0, "levels of calls the text format prints, all of them when 0")
+		flags.BoolVar(&o.color, "color", colorTerminal(), "highlight the text format with colors")
+	}
+}
+
+func (o *options) validate() error {
+	switch o.format {
+	case "", "json", "pretty":
+		return nil
+	case "text":
+		if o.tracer != "" && o.tracer != tenderly.DefaultTracer {
+			return usageError{"The text format prints the call tree of the " + tenderly.DefaultTracer + " tracer only"}
+		}
+		if o.depth < 0 {
+			return usageError{fmt.Sprintf("Invalid depth %d", o.depth)}
+		}
+		return nil
+	}
+
+	return usageError{fmt.Sprintf("Unknown format %s, expected json, pretty or text", o.format)}
+}
+
+// colorTerminal reports whether the standard output is a terminal which
+// colors weren't turned off for.
+func colorTerminal() bool {
+	if os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+
+	info, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// connect connects to the RPC server and loads the contracts of the project.
+func (o *options) connect() (*tenderly.Tenderly, source.Source, error) {
+	t, err := tenderly.NewTenderly(o.rpc)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed connecting to %s, err: %s", o.rpc, err)
+	}
+
+	root := o.project
+	if root == "" {
+		// Transactions of contracts outside any project are traced without
+		// their sources.
+		_, _, err := project.Detect(".")
+		if err != nil {
+			return t, noContracts{}, nil
+		}
+		root = "."
+	}
+
+	p, err := loadProject(t, root)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return t, p.Source, nil
+}
+
+// loadProject loads the project for the network of the node and reports how
+// its artifacts were read.
+func loadProject(t *tenderly.Tenderly, root string) (*project.Project, error) {
+	networkID, err := t.NetworkID()
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := project.Load(root, networkID)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading project %s, err: %s", root, err)
+	}
+	fmt.Fprint(os.Stderr, p.Report)
+
+	return p, nil
+}
+
+func (o *options) traceOptions() *tenderly.TraceOptions {
+	return &tenderly.TraceOptions{
+		Tracer: o.tracer,
+	}
+}
+
+// trace traces the transaction and prints its trace in the selected format.
+func (o *options) trace(t *tenderly.Tenderly, txHash string, cs source.Source) error {
+	if o.format == "text" {
+		tree, err := t.CallTree(txHash, cs)
+		if err != nil {
+			return fmt.Errorf("failed tracing transaction %s, err: %s", txHash, err)
+		}
+
+		return o.render(tree)
+	}
+
+	result, err := t.Trace(txHash, cs, o.traceOptions())
+	if err != nil {
+		return fmt.Errorf("failed tracing transaction %s, err: %s", txHash, err)
+	}
+
+	return o.print(result)
+}
+
+// render writes the call tree as text to the standard output.
+func (o *options) render(tree *calltree.Call) error {
+	return calltree.Render(os.Stdout, tree, calltree.Options{
+		Depth: o.depth,
+		Color: o.color,
+	})
+}
+
+// print writes the result of a tracer to the standard output.
+func (o *options) print(result json.RawMessage) error {
+	if o.format == "pretty" {
+		var out bytes.Buffer
+		err := json.Indent(&out, result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed formatting result, err: %s", err)
+		}
+		result = out.Bytes()
+	}
+
+	_, err := fmt.Fprintf(os.Stdout, "%s\n", result)
+	return err
+}
+
+// noContracts is the source of runs without a project, which know no
+// contracts.
+type noContracts struct{}
+
+func (noContracts) GetSource() source.ContractSource {
+	return source.NewContractSource(nil, nil)
+}
+
+// parse parses the flags of a command, which may come before or after its
+// arguments, and returns the arguments.
+func parse(flags *flag.FlagSet, args []string) ([]string, error) {
+	var arguments []string
+	for {
+		err := flags.Parse(args)
+		if err == flag.ErrHelp {
+			return nil, err
+		}
+		if err != nil {
+			return nil, usageError{}
+		}
+
+		args = flags.Args()
+		if len(args) == 0 {
+			return arguments, nil
+		}
+
+		arguments = append(arguments, args[0])
+		args = args[1:]
+	}
+}
+
+func newFlagSet(name string, arguments string, description string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: tenderly-trace %s [flags]%s\n\n%s\n\nFlags:\n", name, arguments, description)
+		flags.PrintDefaults()
+	}
+
+	return flags
+}
+
+func traceCommand(args []string) error {
+	flags := newFlagSet("trace", " <txhash>", "Re-executes a transaction and prints its trace.")
+	var opts options
+	opts.register(flags, true)
+
+	arguments, err := parse(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(arguments) != 1 {
+		return usageError{"trace takes a single transaction hash"}
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	t, cs, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	return opts.trace(t, arguments[0], cs)
+}
+
+func simulateCommand(args []string) error {
+	flags := newFlagSet("simulate", "", "Executes a call on top of a block without sending it and prints its trace.")
+	var opts options
+	opts.register(flags, true)
+	from := flags.String("from", "", "sender of the call, the zero address by default")
+	to := flags.String("to", "", "called contract, a contract creation when empty")
+	data := flags.String("data", "", "hex encoded input of the call")
+	value := flags.String("value", "0", "wei sent with the call, decimal or hex")
+	gas := flags.Uint64("gas", 0, "gas available to the call, the block gas limit by default")
+	gasPrice := flags.String("gas-price", "0", "gas price in wei, decimal or hex")
+	block := flags.Int64("block", 0, "block to simulate the call in, on the state before it, the one after the latest by default")
+
+	arguments, err := parse(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(arguments) != 0 {
+		return usageError{"simulate takes no arguments"}
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	call := &tenderly.Call{
+		Gas:   *gas,
+		Block: *block,
+	}
+	if *from != "" {
+		if !common.IsHexAddress(*from) {
+			return usageError{fmt.Sprintf("Invalid sender address %s", *from)}
+		}
+		call.From = common.HexToAddress(*from)
+	}
+	if *to != "" {
+		if !common.IsHexAddress(*to) {
+			return usageError{fmt.Sprintf("Invalid contract address %s", *to)}
+		}
+		address := common.HexToAddress(*to)
+		call.To = &address
+	}
+	if *data != "" {
+		input := *data
+		if !strings.HasPrefix(input, "0x") {
+			input = "0x" + input
+		}
+		call.Input, err = hexutil.Decode(input)
+		if err != nil {
+			return usageError{fmt.Sprintf("Invalid call data: %s", err)}
+		}
+	}
+	call.Value, err = parseAmount("value", *value)
+	if err != nil {
+		return err
+	}
+	call.GasPrice, err = parseAmount("gas price", *gasPrice)
+	if err != nil {
+		return err
+	}
+
+	t, cs, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	if opts.format == "text" {
+		tree, err := t.SimulateCallTree(call, cs)
+		if err != nil {
+			return err
+		}
+
+		return opts.render(tree)
+	}
+
+	result, err := t.Simulate(call, cs, opts.traceOptions())
+	if err != nil {
+		return err
+	}
+
+	return opts.print(result)
+}
+
+// parseAmount parses a decimal or 0x prefixed hex amount of wei.
+func parseAmount(name string, value string) (*big.Int, error) {
+	amount, ok := new(big.Int).SetString(value, 0)
+	if !ok || amount.Sign() < 0 {
+		return nil, usageError{fmt.Sprintf("Invalid %s %s", name, value)}
+	}
+
+	return amount, nil
+}
+
+func watchCommand(args []string) error {
+	flags := newFlagSet("watch", "", "Traces the transactions of new blocks as they are mined. Only the\n"+
+		"transactions calling contracts of the project are traced unless --all is set.")
+	var opts options
+	opts.register(flags, true)
+	all := flags.Bool("all", false, "trace every transaction, not only those calling the project's contracts")
+	poll := flags.Bool("poll", false, "poll for new blocks even if the node supports subscriptions")
+
+	arguments, err := parse(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(arguments) != 0 {
+		return usageError{"watch takes no arguments"}
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	t, cs, err := opts.connect()
+	if err != nil {
+		return err
+	}
+
+	blocks, err := t.NewBlocks(*poll)
+	if err != nil {
+		return err
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	for {
+		select {
+		case <-interrupt:
+			return nil
+		case number, ok := <-blocks:
+			if !ok {
+				return fmt.Errorf("block subscription closed")
+			}
+
+			// A failing block or transaction shouldn't stop the watch, so
+			// errors are only reported.
+			hashes, err := t.BlockTransactions(number, cs, *all)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+				continue
+			}
+
+			for _, hash := range hashes {
+				fmt.Fprintf(os.Stderr, "Block %d, transaction %s\n", number, hash)
+
+				err := opts.trace(t, hash, cs)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+				}
+			}
+		}
+	}
+}
+
+func debugCommand(args []string) error {
+	flags := newFlagSet("debug", " <txhash>", "Steps through a transaction in the terminal.")
+	var opts options
+	opts.register(flags, false)
+
+	arguments, err := parse(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(arguments) != 1 {
+		return usageError{"debug takes a single transaction hash"}
+	}
+	if opts.project == "" {
+		return usageError{"debug needs a project to map the execution to source"}
+	}
+
+	t, err := tenderly.NewTenderly(opts.rpc)
+	if err != nil {
+		return fmt.Errorf("failed connecting to %s, err: %s", opts.rpc, err)
+	}
+
+	p, err := loadProject(t, opts.project)
+	if err != nil {
+		return err
+	}
+
+	d, err := t.Debug(arguments[0], p.Source)
+	if err != nil {
+		return fmt.Errorf("failed debugging transaction %s, err: %s", arguments[0], err)
+	}
+
+	return debugger.NewTerminal(d, p.Root, os.Stdin, os.Stdout).Run()
+}
+
+func dapCommand(args []string) error {
+	flags := newFlagSet("dap", "", "Serves the debugger over the Debug Adapter Protocol on the standard input\n"+
+		"and output. The launch request names the transaction, project and RPC server.")
+
+	arguments, err := parse(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(arguments) != 0 {
+		return usageError{"dap takes no arguments"}
+	}
+
+	return dap.NewServer(os.Stdin, os.Stdout, launch).Serve()
+}
+
+// launch records the transaction a debug adapter client asks for.
+func launch(args dap.LaunchArguments) (*debugger.Debugger, error) {
+	rpc := args.Rpc
+	if rpc == "" {
+		rpc = defaultRpc
+	}
+
+	t, err := tenderly.NewTenderly(rpc)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := loadProject(t, args.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Debug(args.TxHash, p.Source)
+}
@@ -98,6 +98,16 @@ func (c *Client) GetBlock(number int64) (ethereum.Block, error) {
 	return resp, nil
 }
 
+func (c *Client) GetBlockHeader(number int64) (ethereum.BlockHeader, error) {
+	req, resp := c.schema.Eth().GetBlockHeaderByNumber(ethereum.Number(number))
+
+	if err := c.rpc.CallRequest(resp, req); err != nil {
+		return nil, fmt.Errorf("get block header by number [%d]: %s", number, err)
+	}
+
+	return resp, nil
+}
+
 func (c *Client) GetBlockByHash(hash string) (ethereum.BlockHeader, error) {
 	req, resp := c.schema.Eth().GetBlockByHash(hash)
 
@@ -170,6 +180,16 @@ func (c *Client) GetBalance(address string, block ethereum.Number) (*big.Int, er
 	return resp.ToInt(), nil
 }
 
+func (c *Client) GetNonce(address string, block ethereum.Number) (uint64, error) {
+	req, resp := c.schema.Eth().GetTransactionCount(address, block)
+
+	if err := c.rpc.CallRequest(resp, req); err != nil {
+		return 0, fmt.Errorf("get nonce [%s]: %s", address, err)
+	}
+
+	return uint64(*resp), nil
+}
+
 func (c *Client) GetCode(address string, block ethereum.Number) (*string, error) {
 	req, resp := c.schema.Eth().GetCode(address, block)
 
@@ -229,9 +249,9 @@ func (c *Client) subscribeViaPoll() (chan int64, error) {
 			}
 
 			for lastBlock < blockNumber {
-				outCh <- blockNumber
-
 				lastBlock++
+
+				outCh <- lastBlock
 			}
 
 			time.Sleep(200 * time.Millisecond)
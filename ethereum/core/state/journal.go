@@ -0,0 +1,68 @@
+package state
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// journalEntry is a modification of the cache which can be reverted. Values
+// the cache is filled with from the node aren't modifications, since they
+// hold at every revision.
+type journalEntry interface {
+	revert(cache *Cache)
+}
+
+type (
+	balanceChange struct {
+		account common.Address
+		prev    *big.Int
+	}
+	nonceChange struct {
+		account common.Address
+		prev    uint64
+		cached  bool
+	}
+	codeChange struct {
+		account common.Address
+		prev    *[]byte
+	}
+	storageChange struct {
+		account common.Address
+		key     common.Hash
+		prev    common.Hash
+		cached  bool
+	}
+)
+
+func (ch balanceChange) revert(cache *Cache) {
+	if ch.prev == nil {
+		delete(cache.balance, ch.account)
+		return
+	}
+	cache.balance[ch.account] = ch.prev
+}
+
+func (ch nonceChange) revert(cache *Cache) {
+	if !ch.cached {
+		delete(cache.nonce, ch.account)
+		return
+	}
+	cache.nonce[ch.account] = ch.prev
+}
+
+func (ch codeChange) revert(cache *Cache) {
+	if ch.prev == nil {
+		delete(cache.code, ch.account)
+		return
+	}
+	cache.code[ch.account] = ch.prev
+}
+
+func (ch storageChange) revert(cache *Cache) {
+	if !ch.cached {
+		delete(cache.state[ch.account], ch.key)
+		return
+	}
+	cache.state[ch.account][ch.key] = ch.prev
+}
@@ -19,10 +19,13 @@ package state
 
 import (
 	"encoding/hex"
+	"fmt"
 	"github.com/tenderly/tenderly-trace/ethereum"
 	"github.com/tenderly/tenderly-trace/ethereum/client"
 	types2 "github.com/tenderly/tenderly-trace/ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum/signer/accounts/abi"
 	"math/big"
+	"sort"
 	"strings"
 	"sync"
 
@@ -34,6 +37,8 @@ import (
 type revision struct {
 	id           int
 	journalIndex int
+	logIndex     int
+	refund       uint64
 }
 
 var (
@@ -46,6 +51,7 @@ var (
 
 type Cache struct {
 	balance map[common.Address]*big.Int
+	nonce   map[common.Address]uint64
 	code    map[common.Address]*[]byte
 	state   map[common.Address]map[common.Hash]common.Hash
 }
@@ -53,14 +59,20 @@ type Cache struct {
 func NewCache() *Cache {
 	return &Cache{
 		balance: make(map[common.Address]*big.Int),
+		nonce:   make(map[common.Address]uint64),
 		code:    make(map[common.Address]*[]byte),
 		state:   make(map[common.Address]map[common.Hash]common.Hash),
 	}
 }
 
+// ContractSource looks up the contract deployed at an address, with the given
+// hex encoded code.
 type ContractSource interface {
-	GetAst(string) types2.Ast
-	GetStateVariables(string) []*types2.Node
+	GetAst(address string, code string) types2.Ast
+	GetStateVariables(address string, code string) []*types2.Node
+	GetDefinitions(address string, code string) types2.Definitions
+	GetStorageLayout(address string, code string) *types2.StorageLayout
+	GetAbi(address string, code string) *abi.ABI
 }
 
 // StateDBs within the ethereum protocol are used to store anything
@@ -96,6 +108,7 @@ type StateDB struct {
 
 	// Journal of state modifications. This is the backbone of
 	// Snapshot and RevertToSnapshot.
+	journal        []journalEntry
 	validRevisions []revision
 	nextRevisionId int
 
@@ -117,7 +130,17 @@ func New(client client.Client, blockNumber int64, source ContractSource) *StateD
 }
 
 func (self *StateDB) AddLog(log *types.Log) {
-	return
+	log.TxHash = self.thash
+	log.BlockHash = self.bhash
+	log.TxIndex = uint(self.txIndex)
+	log.Index = self.logSize
+	self.logs[self.thash] = append(self.logs[self.thash], log)
+	self.logSize++
+}
+
+// Logs returns the logs emitted by the execution which weren't reverted.
+func (self *StateDB) Logs() []*types.Log {
+	return self.logs[self.thash]
 }
 
 // AddPreimage records a SHA3 preimage seen by the VM.
@@ -126,6 +149,7 @@ func (self *StateDB) AddPreimage(hash common.Hash, preimage []byte) {
 }
 
 func (self *StateDB) AddRefund(gas uint64) {
+	self.refund += gas
 }
 
 // Exist reports whether the given account address exists in the state.
@@ -142,29 +166,59 @@ func (self *StateDB) Empty(addr common.Address) bool {
 
 // Retrieve the balance from the given address or 0 if object not found
 func (self *StateDB) GetBalance(addr common.Address) *big.Int {
-	balance, err := self.client.GetBalance(addr.String(), ethereum.Number(self.blockNumber))
+	if balance, ok := self.cache.balance[addr]; ok {
+		return new(big.Int).Set(balance)
+	}
+	balance, err := self.client.GetBalance(addr.String(), ethereum.Number(self.blockNumber-1))
 	if err != nil || balance == nil {
 		self.cache.balance[addr] = big.NewInt(0)
 		return big.NewInt(0)
 	}
 	self.cache.balance[addr] = balance
-	return balance
+	return new(big.Int).Set(balance)
 }
 
+// Retrieve the nonce from the given address or 0 if object not found
 func (self *StateDB) GetNonce(addr common.Address) uint64 {
-	return 0
+	if nonce, ok := self.cache.nonce[addr]; ok {
+		return nonce
+	}
+	nonce, err := self.client.GetNonce(addr.String(), ethereum.Number(self.blockNumber-1))
+	if err != nil {
+		return 0
+	}
+	self.cache.nonce[addr] = nonce
+	return nonce
 }
 
 func (self *StateDB) GetCodeAst(addr common.Address) types2.Ast {
 	code := self.GetCode(addr)
 
-	return self.source.GetAst("0x" + hex.EncodeToString(code))
+	return self.source.GetAst(addr.Hex(), "0x"+hex.EncodeToString(code))
 }
 
 func (self *StateDB) GetStateVariables(addr common.Address) []*types2.Node {
 	code := self.GetCode(addr)
 
-	return self.source.GetStateVariables("0x" + hex.EncodeToString(code))
+	return self.source.GetStateVariables(addr.Hex(), "0x"+hex.EncodeToString(code))
+}
+
+func (self *StateDB) GetDefinitions(addr common.Address) types2.Definitions {
+	code := self.GetCode(addr)
+
+	return self.source.GetDefinitions(addr.Hex(), "0x"+hex.EncodeToString(code))
+}
+
+func (self *StateDB) GetStorageLayout(addr common.Address) *types2.StorageLayout {
+	code := self.GetCode(addr)
+
+	return self.source.GetStorageLayout(addr.Hex(), "0x"+hex.EncodeToString(code))
+}
+
+func (self *StateDB) GetAbi(addr common.Address) *abi.ABI {
+	code := self.GetCode(addr)
+
+	return self.source.GetAbi(addr.Hex(), "0x"+hex.EncodeToString(code))
 }
 
 func (self *StateDB) GetCode(addr common.Address) []byte {
@@ -172,7 +226,7 @@ func (self *StateDB) GetCode(addr common.Address) []byte {
 	if codeCache != nil {
 		return *codeCache
 	}
-	code, err := self.client.GetCode(addr.String(), ethereum.Number(self.blockNumber))
+	code, err := self.client.GetCode(addr.String(), ethereum.Number(self.blockNumber-1))
 	if err != nil {
 		return []byte{}
 	}
@@ -184,7 +238,7 @@ func (self *StateDB) GetCode(addr common.Address) []byte {
 	if err != nil {
 		return []byte{}
 	}
-	self.SetCode(addr, bin)
+	self.cache.code[addr] = &bin
 	return bin
 }
 
@@ -217,7 +271,7 @@ func (self *StateDB) GetState(addr common.Address, bhash common.Hash) common.Has
 	}
 	data, _ := self.client.GetStorageAt(addr.String(), bhash, ethereum.Number(self.blockNumber-1))
 	if data != nil {
-		self.SetState(addr, bhash, *data)
+		stateCache[bhash] = *data
 		return *data
 	}
 	return common.Hash{}
@@ -233,26 +287,26 @@ func (self *StateDB) HasSuicided(addr common.Address) bool {
 
 // AddBalance adds amount to the account associated with addr.
 func (self *StateDB) AddBalance(addr common.Address, amount *big.Int) {
-	balanceCache := self.cache.balance[addr]
-	if balanceCache == nil {
-		balanceCache = self.GetBalance(addr)
-	}
-	balanceCache.Add(balanceCache, amount)
+	balance := self.GetBalance(addr)
+	self.journal = append(self.journal, balanceChange{account: addr, prev: self.cache.balance[addr]})
+	self.cache.balance[addr] = balance.Add(balance, amount)
 }
 
 // SubBalance subtracts amount from the account associated with addr.
 func (self *StateDB) SubBalance(addr common.Address, amount *big.Int) {
-	balanceCache := self.cache.balance[addr]
-	if balanceCache == nil {
-		balanceCache = self.GetBalance(addr)
-	}
-	balanceCache.Sub(balanceCache, amount)
+	balance := self.GetBalance(addr)
+	self.journal = append(self.journal, balanceChange{account: addr, prev: self.cache.balance[addr]})
+	self.cache.balance[addr] = balance.Sub(balance, amount)
 }
 
 func (self *StateDB) SetNonce(addr common.Address, nonce uint64) {
+	prev, cached := self.cache.nonce[addr]
+	self.journal = append(self.journal, nonceChange{account: addr, prev: prev, cached: cached})
+	self.cache.nonce[addr] = nonce
 }
 
 func (self *StateDB) SetCode(addr common.Address, code []byte) {
+	self.journal = append(self.journal, codeChange{account: addr, prev: self.cache.code[addr]})
 	self.cache.code[addr] = &code
 }
 
@@ -260,6 +314,8 @@ func (self *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	if self.cache.state[addr] == nil {
 		self.cache.state[addr] = make(map[common.Hash]common.Hash)
 	}
+	prev, cached := self.cache.state[addr][key]
+	self.journal = append(self.journal, storageChange{account: addr, key: key, prev: prev, cached: cached})
 	self.cache.state[addr][key] = value
 }
 
@@ -289,15 +345,44 @@ func (db *StateDB) ForEachStorage(addr common.Address, cb func(key, value common
 }
 
 // Snapshot returns an identifier for the current revision of the state.
+//
+// Balance, nonce, code and storage changes are journaled together with the
+// emitted logs and the refund counter, so reverting to the revision undoes
+// all of them.
 func (self *StateDB) Snapshot() int {
-	return 0
+	id := self.nextRevisionId
+	self.nextRevisionId++
+	self.validRevisions = append(self.validRevisions, revision{
+		id:           id,
+		journalIndex: len(self.journal),
+		logIndex:     len(self.logs[self.thash]),
+		refund:       self.refund,
+	})
+	return id
 }
 
 // RevertToSnapshot reverts all state changes made since the given revision.
 func (self *StateDB) RevertToSnapshot(revid int) {
+	idx := sort.Search(len(self.validRevisions), func(i int) bool {
+		return self.validRevisions[i].id >= revid
+	})
+	if idx == len(self.validRevisions) || self.validRevisions[idx].id != revid {
+		panic(fmt.Errorf("revision id %v cannot be reverted", revid))
+	}
+	snapshot := self.validRevisions[idx]
+
+	for i := len(self.journal) - 1; i >= snapshot.journalIndex; i-- {
+		self.journal[i].revert(self.cache)
+	}
+	self.journal = self.journal[:snapshot.journalIndex]
+
+	self.logs[self.thash] = self.logs[self.thash][:snapshot.logIndex]
+	self.logSize = uint(snapshot.logIndex)
+	self.refund = snapshot.refund
+	self.validRevisions = self.validRevisions[:idx]
 }
 
 // GetRefund returns the current value of the refund counter.
 func (self *StateDB) GetRefund() uint64 {
-	return 0
+	return self.refund
 }
@@ -0,0 +1,56 @@
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum/client"
+)
+
+func TestRevertToSnapshot(t *testing.T) {
+	account := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	slot := common.HexToHash("0x01")
+
+	db := New(client.Client{}, 1, nil)
+	// The values the node would report for the account.
+	db.cache.balance[account] = big.NewInt(100)
+	db.cache.nonce[account] = 1
+	db.cache.state[account] = map[common.Hash]common.Hash{slot: common.HexToHash("0x0a")}
+
+	db.SetState(account, slot, common.HexToHash("0x0b"))
+	db.AddLog(&types.Log{Address: account})
+
+	snapshot := db.Snapshot()
+	db.AddBalance(account, big.NewInt(5))
+	db.SetNonce(account, 2)
+	db.SetCode(account, []byte{0x60, 0x00})
+	db.SetState(account, slot, common.HexToHash("0x0c"))
+	db.SetState(account, common.HexToHash("0x02"), common.HexToHash("0x0d"))
+	db.AddRefund(10)
+	db.AddLog(&types.Log{Address: account})
+	db.RevertToSnapshot(snapshot)
+
+	if balance := db.GetBalance(account); balance.Cmp(big.NewInt(100)) != 0 {
+		t.Errorf("expected balance 100, got %s", balance)
+	}
+	if nonce := db.GetNonce(account); nonce != 1 {
+		t.Errorf("expected nonce 1, got %d", nonce)
+	}
+	if _, ok := db.cache.code[account]; ok {
+		t.Errorf("expected no cached code")
+	}
+	if value := db.GetState(account, slot); value != common.HexToHash("0x0b") {
+		t.Errorf("expected the value stored before the snapshot, got %s", value.Hex())
+	}
+	if _, ok := db.cache.state[account][common.HexToHash("0x02")]; ok {
+		t.Errorf("expected the slot stored after the snapshot uncached")
+	}
+	if refund := db.GetRefund(); refund != 0 {
+		t.Errorf("expected no refund, got %d", refund)
+	}
+	if logs := db.Logs(); len(logs) != 1 {
+		t.Errorf("expected 1 log, got %d", len(logs))
+	}
+}
@@ -50,12 +50,18 @@ type Parameters struct {
 }
 
 type Node struct {
+	Id               int        `json:"id"`
 	Name             string     `json:"name"`
 	NodeType         string     `json:"nodeType"`
 	TypeName         TypeName   `json:"typeName"`
 	StateVariable    bool       `json:"stateVariable"`
 	Parameters       Parameters `json:"parameters"`
 	ReturnParameters Parameters `json:"returnParameters"`
+	Members          []Node     `json:"members,omitempty"`
 }
 
 type Ast map[uint]*Node
+
+// Definitions holds the struct and enum definitions a contract can refer to,
+// keyed by their AST id.
+type Definitions map[int]*Node
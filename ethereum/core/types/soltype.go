@@ -0,0 +1,338 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TypeKind is the kind of a Solidity type.
+type TypeKind int
+
+const (
+	UnknownKind TypeKind = iota
+	UintKind
+	IntKind
+	BoolKind
+	AddressKind
+	FixedBytesKind
+	BytesKind
+	StringKind
+	EnumKind
+	ContractKind
+	FunctionKind
+	ArrayKind
+	StructKind
+	MappingKind
+)
+
+// DataLocation is where the data of a reference type is kept. Value types
+// live on the stack.
+type DataLocation int
+
+const (
+	StackLocation DataLocation = iota
+	MemoryLocation
+	StorageLocation
+	CalldataLocation
+)
+
+var locationSuffixes = []struct {
+	suffix   string
+	location DataLocation
+	pointer  bool
+}{
+	{"_storage_ptr", StorageLocation, true},
+	{"_storage", StorageLocation, false},
+	{"_memory_ptr", MemoryLocation, true},
+	{"_memory", MemoryLocation, false},
+	{"_calldata_ptr", CalldataLocation, true},
+	{"_calldata", CalldataLocation, false},
+}
+
+// SolType is a Solidity type parsed from the type identifier the compiler
+// reports in the typeDescriptions of AST nodes, e.g. t_uint256,
+// t_array$_t_address_$dyn_memory_ptr or t_struct$_Order_$42_storage_ptr.
+type SolType struct {
+	Kind TypeKind
+	// Size is the number of bits of integers and the number of bytes of fixed
+	// size byte arrays.
+	Size int
+	// Length is the length of static arrays, zero for dynamic ones.
+	Length int
+	// Elem is the element type of arrays.
+	Elem *SolType
+	// Key and Value are the key and value types of mappings.
+	Key   *SolType
+	Value *SolType
+	// Name and Id are the name and the AST id of the declaration of structs,
+	// enums and contracts.
+	Name string
+	Id   int
+
+	Location DataLocation
+	Pointer  bool
+
+	Identifier string
+}
+
+// IsDynamicArray reports whether the type is an array without a fixed length.
+func (t *SolType) IsDynamicArray() bool {
+	return t.Kind == ArrayKind && t.Length == 0
+}
+
+// IsReference reports whether the type is a reference to data kept in memory,
+// storage or calldata.
+func (t *SolType) IsReference() bool {
+	switch t.Kind {
+	case BytesKind, StringKind, ArrayKind, StructKind, MappingKind:
+		return true
+	}
+
+	return false
+}
+
+// WithLocation returns a copy of a reference type moved to the given location,
+// as needed for struct members and array elements which are declared in
+// storage but reside wherever their parent does.
+func (t *SolType) WithLocation(location DataLocation) *SolType {
+	if !t.IsReference() || t.Location == location {
+		return t
+	}
+
+	moved := *t
+	moved.Location = location
+	moved.Pointer = location != StorageLocation
+	if moved.Elem != nil {
+		moved.Elem = moved.Elem.WithLocation(location)
+	}
+
+	return &moved
+}
+
+// LayoutKey returns the key the compiler gives the type among the types of a
+// storageLayout, e.g. t_struct(Order)42_storage for the type identifier
+// t_struct$_Order_$42_storage_ptr. Storage layouts computed from the AST key
+// their types by the type identifier instead.
+func (t *SolType) LayoutKey() string {
+	switch t.Kind {
+	case ArrayKind:
+		length := "dyn"
+		if t.Length > 0 {
+			length = strconv.Itoa(t.Length)
+		}
+		return "t_array(" + t.Elem.WithLocation(StorageLocation).LayoutKey() + ")" + length + "_storage"
+	case MappingKind:
+		// Keys of reference types are kept in memory while hashing them.
+		return "t_mapping(" + t.Key.Identifier + "," + t.Value.WithLocation(StorageLocation).LayoutKey() + ")"
+	case StructKind:
+		return "t_struct(" + t.Name + ")" + strconv.Itoa(t.Id) + "_storage"
+	case EnumKind:
+		return "t_enum(" + t.Name + ")" + strconv.Itoa(t.Id)
+	case ContractKind:
+		return "t_contract(" + t.Name + ")" + strconv.Itoa(t.Id)
+	case StringKind:
+		return "t_string_storage"
+	case BytesKind:
+		return "t_bytes_storage"
+	}
+
+	return t.Identifier
+}
+
+// ParseTypeIdentifier parses a type identifier as generated by the Solidity
+// compiler. Types which can't hold a value, like literals or tuples, are
+// reported as UnknownKind.
+func ParseTypeIdentifier(identifier string) (*SolType, error) {
+	t := &SolType{
+		Identifier: identifier,
+	}
+
+	switch {
+	case strings.HasPrefix(identifier, "t_array$_"):
+		inner, rest, err := splitParenthesized(identifier, len("t_array$_"))
+		if err != nil {
+			return nil, err
+		}
+
+		t.Kind = ArrayKind
+		t.Elem, err = ParseTypeIdentifier(inner)
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.HasPrefix(rest, "dyn") {
+			rest = rest[len("dyn"):]
+		} else {
+			digits := leadingDigits(rest)
+			if digits == "" {
+				return nil, fmt.Errorf("missing array length in type identifier %s", identifier)
+			}
+			t.Length, _ = strconv.Atoi(digits)
+			rest = rest[len(digits):]
+		}
+
+		return t, t.parseLocation(rest)
+	case strings.HasPrefix(identifier, "t_mapping$_"):
+		closing, separators, err := scanParenthesized(identifier, len("t_mapping$_"))
+		if err != nil {
+			return nil, err
+		}
+		if len(separators) != 1 {
+			return nil, fmt.Errorf("malformed mapping type identifier %s", identifier)
+		}
+		separator := separators[0]
+
+		t.Kind = MappingKind
+		t.Location = StorageLocation
+		t.Key, err = ParseTypeIdentifier(identifier[len("t_mapping$_"):separator])
+		if err != nil {
+			return nil, err
+		}
+		t.Value, err = ParseTypeIdentifier(identifier[separator+len("_$_") : closing])
+		if err != nil {
+			return nil, err
+		}
+
+		return t, nil
+	case strings.HasPrefix(identifier, "t_struct$_"),
+		strings.HasPrefix(identifier, "t_enum$_"),
+		strings.HasPrefix(identifier, "t_contract$_"):
+		open := strings.Index(identifier, "$_")
+		inner, rest, err := splitParenthesized(identifier, open+len("$_"))
+		if err != nil {
+			return nil, err
+		}
+
+		switch identifier[:open] {
+		case "t_struct":
+			t.Kind = StructKind
+		case "t_enum":
+			t.Kind = EnumKind
+		case "t_contract":
+			t.Kind = ContractKind
+		}
+		t.Name = inner
+
+		digits := leadingDigits(rest)
+		t.Id, _ = strconv.Atoi(digits)
+
+		return t, t.parseLocation(rest[len(digits):])
+	case strings.HasPrefix(identifier, "t_function"):
+		t.Kind = FunctionKind
+		return t, nil
+	}
+
+	name := identifier
+	for _, location := range locationSuffixes {
+		if strings.HasSuffix(name, location.suffix) {
+			name = strings.TrimSuffix(name, location.suffix)
+			t.Location = location.location
+			t.Pointer = location.pointer
+			break
+		}
+	}
+
+	switch {
+	case name == "t_bool":
+		t.Kind = BoolKind
+	case name == "t_address" || name == "t_address_payable":
+		t.Kind = AddressKind
+	case name == "t_string":
+		t.Kind = StringKind
+	case name == "t_bytes":
+		t.Kind = BytesKind
+	case name == "t_byte":
+		t.Kind = FixedBytesKind
+		t.Size = 1
+	case strings.HasPrefix(name, "t_uint"):
+		t.Kind = UintKind
+		t.Size = parseSize(name[len("t_uint"):], 256)
+	case strings.HasPrefix(name, "t_int"):
+		t.Kind = IntKind
+		t.Size = parseSize(name[len("t_int"):], 256)
+	case strings.HasPrefix(name, "t_bytes"):
+		t.Kind = FixedBytesKind
+		t.Size = parseSize(name[len("t_bytes"):], 32)
+	}
+
+	return t, nil
+}
+
+func (t *SolType) parseLocation(rest string) error {
+	if rest == "" {
+		return nil
+	}
+
+	for _, location := range locationSuffixes {
+		if rest == location.suffix {
+			t.Location = location.location
+			t.Pointer = location.pointer
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unexpected %s in type identifier %s", rest, t.Identifier)
+}
+
+// splitParenthesized returns the identifier enclosed in the "$_" "_$"
+// parentheses opened right before start, and whatever follows them.
+func splitParenthesized(identifier string, start int) (string, string, error) {
+	closing, _, err := scanParenthesized(identifier, start)
+	if err != nil {
+		return "", "", err
+	}
+
+	return identifier[start:closing], identifier[closing+len("_$"):], nil
+}
+
+// scanParenthesized walks the identifier from start, right after an opening
+// "$_", and returns the index of the matching "_$" together with the indexes
+// of the "_$_" separators of the outermost list, like the one between the key
+// and the value of a mapping.
+func scanParenthesized(identifier string, start int) (int, []int, error) {
+	var separators []int
+
+	depth := 1
+	for i := start; i < len(identifier)-1; i++ {
+		switch identifier[i : i+2] {
+		case "_$":
+			if strings.HasPrefix(identifier[i:], "_$_t_") {
+				if depth == 1 {
+					separators = append(separators, i)
+				}
+				i += len("_$_") - 1
+				continue
+			}
+
+			depth--
+			if depth == 0 {
+				return i, separators, nil
+			}
+			i++
+		case "$_":
+			depth++
+			i++
+		}
+	}
+
+	return 0, nil, fmt.Errorf("unbalanced type identifier %s", identifier)
+}
+
+func leadingDigits(s string) string {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+
+	return s[:i]
+}
+
+func parseSize(s string, fallback int) int {
+	size, err := strconv.Atoi(s)
+	if err != nil || size == 0 {
+		return fallback
+	}
+
+	return size
+}
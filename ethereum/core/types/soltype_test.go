@@ -0,0 +1,78 @@
+package types
+
+import "testing"
+
+func TestParseTypeIdentifier(t *testing.T) {
+	tests := []struct {
+		identifier string
+		kind       TypeKind
+		size       int
+		length     int
+		location   DataLocation
+		check      func(t *SolType) bool
+	}{
+		{identifier: "t_uint256", kind: UintKind, size: 256},
+		{identifier: "t_int8", kind: IntKind, size: 8},
+		{identifier: "t_bool", kind: BoolKind},
+		{identifier: "t_address_payable", kind: AddressKind},
+		{identifier: "t_bytes4", kind: FixedBytesKind, size: 4},
+		{identifier: "t_bytes_calldata_ptr", kind: BytesKind, location: CalldataLocation},
+		{identifier: "t_string_memory_ptr", kind: StringKind, location: MemoryLocation},
+		{identifier: "t_enum$_Status_$12", kind: EnumKind, check: func(t *SolType) bool {
+			return t.Name == "Status" && t.Id == 12
+		}},
+		{identifier: "t_struct$_Order_$42_storage_ptr", kind: StructKind, location: StorageLocation, check: func(t *SolType) bool {
+			return t.Name == "Order" && t.Id == 42 && t.Pointer
+		}},
+		{identifier: "t_array$_t_address_$dyn_memory_ptr", kind: ArrayKind, location: MemoryLocation, check: func(t *SolType) bool {
+			return t.IsDynamicArray() && t.Elem.Kind == AddressKind
+		}},
+		{identifier: "t_array$_t_array$_t_uint8_$3_memory_$dyn_memory_ptr", kind: ArrayKind, location: MemoryLocation, check: func(t *SolType) bool {
+			return t.Elem.Kind == ArrayKind && t.Elem.Length == 3 && t.Elem.Elem.Size == 8
+		}},
+		{identifier: "t_mapping$_t_address_$_t_mapping$_t_uint256_$_t_struct$_S_$5_storage_$_$", kind: MappingKind, location: StorageLocation, check: func(t *SolType) bool {
+			return t.Key.Kind == AddressKind && t.Value.Kind == MappingKind && t.Value.Value.Kind == StructKind
+		}},
+		{identifier: "t_function_internal_pure$_t_uint256_$returns$_t_bool_$", kind: FunctionKind},
+		{identifier: "t_rational_1_by_1", kind: UnknownKind},
+	}
+
+	for _, test := range tests {
+		typ, err := ParseTypeIdentifier(test.identifier)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.identifier, err)
+			continue
+		}
+		if typ.Kind != test.kind || typ.Size != test.size || typ.Length != test.length || typ.Location != test.location {
+			t.Errorf("%s: parsed as %+v", test.identifier, typ)
+		}
+		if test.check != nil && !test.check(typ) {
+			t.Errorf("%s: unexpected components %+v", test.identifier, typ)
+		}
+	}
+}
+
+func TestLayoutKey(t *testing.T) {
+	tests := map[string]string{
+		"t_uint256":                                               "t_uint256",
+		"t_string_storage_ptr":                                    "t_string_storage",
+		"t_bytes_storage":                                         "t_bytes_storage",
+		"t_struct$_Order_$42_storage_ptr":                         "t_struct(Order)42_storage",
+		"t_enum$_Status_$12":                                      "t_enum(Status)12",
+		"t_array$_t_struct$_S_$5_storage_$3_storage_ptr":          "t_array(t_struct(S)5_storage)3_storage",
+		"t_array$_t_array$_t_uint8_$dyn_storage_$dyn_storage_ptr": "t_array(t_array(t_uint8)dyn_storage)dyn_storage",
+		"t_mapping$_t_string_memory_ptr_$_t_mapping$_t_address_$_t_bool_$_$":  "t_mapping(t_string_memory_ptr,t_mapping(t_address,t_bool))",
+		"t_mapping$_t_address_$_t_array$_t_contract$_Token_$7_$dyn_storage_$": "t_mapping(t_address,t_array(t_contract(Token)7)dyn_storage)",
+	}
+
+	for identifier, expected := range tests {
+		typ, err := ParseTypeIdentifier(identifier)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", identifier, err)
+			continue
+		}
+		if key := typ.LayoutKey(); key != expected {
+			t.Errorf("%s: expected key %s, got %s", identifier, expected, key)
+		}
+	}
+}
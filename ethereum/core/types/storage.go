@@ -0,0 +1,41 @@
+package types
+
+// Storage encodings of the types of a storage layout.
+const (
+	InplaceEncoding      = "inplace"
+	MappingEncoding      = "mapping"
+	DynamicArrayEncoding = "dynamic_array"
+	BytesEncoding        = "bytes"
+)
+
+// StorageLayout is the placement of the state variables of a contract in
+// storage, in the format of the storageLayout output of the Solidity compiler.
+type StorageLayout struct {
+	Storage []*StorageVariable      `json:"storage"`
+	Types   map[string]*StorageType `json:"types"`
+}
+
+// StorageVariable is a state variable, or a struct member, kept at an offset
+// of a storage slot. The slots of struct members are relative to the slot of
+// the struct. Slot is a decimal number, as reported by the compiler.
+type StorageVariable struct {
+	AstId    int    `json:"astId"`
+	Contract string `json:"contract"`
+	Label    string `json:"label"`
+	Offset   int    `json:"offset"`
+	Slot     string `json:"slot"`
+	Type     string `json:"type"`
+}
+
+// StorageType describes how the values of a type are kept in storage. Base is
+// the element type of arrays, Key and Value the types of mappings and Members
+// the layout of structs.
+type StorageType struct {
+	Encoding      string             `json:"encoding"`
+	Label         string             `json:"label"`
+	NumberOfBytes string             `json:"numberOfBytes"`
+	Base          string             `json:"base,omitempty"`
+	Key           string             `json:"key,omitempty"`
+	Value         string             `json:"value,omitempty"`
+	Members       []*StorageVariable `json:"members,omitempty"`
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/tenderly/tenderly-trace/ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum/signer/accounts/abi"
 	"math/big"
 )
 
@@ -28,6 +29,9 @@ type Contract struct {
 
 	Ast            types.Ast
 	StateVariables []*types.Node
+	Definitions    types.Definitions
+	StorageLayout  *types.StorageLayout
+	Abi            *abi.ABI
 	Code           []byte
 	CodeHash       common.Hash
 	CodeAddr       *common.Address
@@ -142,10 +146,13 @@ func (c *Contract) SetCode(hash common.Hash, code []byte) {
 
 // SetCallCode sets the code of the contract and address of the backing data
 // object
-func (c *Contract) SetCallCode(addr *common.Address, hash common.Hash, code []byte, ast types.Ast, stateVariables []*types.Node) {
+func (c *Contract) SetCallCode(addr *common.Address, hash common.Hash, code []byte, ast types.Ast, stateVariables []*types.Node, definitions types.Definitions, storageLayout *types.StorageLayout, contractAbi *abi.ABI) {
 	c.Code = code
 	c.CodeHash = hash
 	c.CodeAddr = addr
 	c.Ast = ast
 	c.StateVariables = stateVariables
+	c.Definitions = definitions
+	c.StorageLayout = storageLayout
+	c.Abi = contractAbi
 }
@@ -2,6 +2,7 @@ package vm
 
 import (
 	types2 "github.com/tenderly/tenderly-trace/ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum/signer/accounts/abi"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -21,6 +22,9 @@ type StateDB interface {
 
 	GetCodeAst(address common.Address) types2.Ast
 	GetStateVariables(address common.Address) []*types2.Node
+	GetDefinitions(address common.Address) types2.Definitions
+	GetStorageLayout(address common.Address) *types2.StorageLayout
+	GetAbi(address common.Address) *abi.ABI
 	GetCodeHash(common.Address) common.Hash
 	GetCode(common.Address) []byte //instructions.go is calling
 	SetCode(common.Address, []byte)
@@ -0,0 +1,149 @@
+package tracers
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tenderly/tenderly-trace/ethereum/signer/accounts/abi"
+	"github.com/tenderly/tenderly-trace/ethereum/signer/accounts/signer/core"
+)
+
+// decodedCall is the function an external call invokes, with its inputs.
+type decodedCall struct {
+	Signature string         `json:"signature"`
+	Name      string         `json:"name"`
+	Inputs    []*revertInput `json:"inputs"`
+
+	// Candidates lists the signatures the call matches when it was decoded
+	// through the signature database and more than one did.
+	Candidates []string `json:"candidates,omitempty"`
+}
+
+// decodedLog is the event a log was emitted by, with its inputs.
+type decodedLog struct {
+	Signature string         `json:"signature"`
+	Name      string         `json:"name"`
+	Inputs    []*revertInput `json:"inputs"`
+
+	// Candidates lists the signatures the log matches when it was decoded
+	// through the signature database and more than one did.
+	Candidates []string `json:"candidates,omitempty"`
+}
+
+// decodeCallInput decodes the calldata of an external call against the ABI
+// of the callee, falling back to the signature database for callees without
+// one or functions missing from it, like the ones of proxied implementations.
+// It returns nil if the called function is unknown.
+func decodeCallInput(contractAbi *abi.ABI, input []byte) *decodedCall {
+	if contractAbi != nil {
+		decoded, err := core.DecodeCallData(input, contractAbi)
+		if err == nil {
+			return newDecodedCall(decoded)
+		}
+	}
+
+	db := signatureDatabase()
+	if db == nil {
+		return nil
+	}
+
+	decoded, matches, err := db.DecodeCall(input)
+	if err != nil {
+		return nil
+	}
+
+	call := newDecodedCall(decoded)
+	if len(matches) > 1 {
+		call.Candidates = matches
+	}
+	return call
+}
+
+// decodeCallOutput decodes the data an external call returned with, against
+// the outputs of the function its calldata selects.
+func decodeCallOutput(contractAbi *abi.ABI, input []byte, output []byte) []*revertInput {
+	if contractAbi == nil || len(input) < 4 {
+		return nil
+	}
+
+	method, err := contractAbi.MethodById(input)
+	if err != nil {
+		return nil
+	}
+
+	decoded, err := core.DecodeReturnData(output, method)
+	if err != nil {
+		return nil
+	}
+
+	return formatArguments(decoded)
+}
+
+// decodeLog decodes a log against the events of the ABI of the emitting code,
+// falling back to the signature database for code without one or events
+// missing from it, like the ones emitted by libraries. It returns nil if the
+// event is unknown.
+func decodeLog(contractAbi *abi.ABI, topics []common.Hash, data []byte) *decodedLog {
+	if len(topics) == 0 {
+		return nil
+	}
+
+	if contractAbi != nil {
+		event, err := contractAbi.EventById(topics[0])
+		if err == nil {
+			inputs, err := core.DecodeLog(event, topics, data)
+			if err == nil {
+				return &decodedLog{
+					Signature: event.Sig(),
+					Name:      event.Name,
+					Inputs:    formatArguments(inputs),
+				}
+			}
+		}
+	}
+
+	db := signatureDatabase()
+	if db == nil {
+		return nil
+	}
+
+	decoded, matches, err := db.DecodeLog(topics, data)
+	if err != nil {
+		return nil
+	}
+
+	log := &decodedLog{
+		Signature: decoded.Signature,
+		Name:      decoded.Name,
+		Inputs:    formatArguments(decoded.Inputs),
+	}
+	if len(matches) > 1 {
+		log.Candidates = matches
+	}
+	return log
+}
+
+func newDecodedCall(decoded *core.DecodedCallData) *decodedCall {
+	return &decodedCall{
+		Signature: decoded.Signature,
+		Name:      decoded.Name,
+		Inputs:    formatArguments(decoded.Inputs),
+	}
+}
+
+// formatArguments formats decoded arguments the same way the inputs of
+// custom errors are reported.
+func formatArguments(arguments []core.DecodedArgument) []*revertInput {
+	if arguments == nil {
+		return nil
+	}
+
+	formatted := make([]*revertInput, len(arguments))
+	for i, argument := range arguments {
+		formatted[i] = &revertInput{
+			Name:  argument.Soltype.Name,
+			Type:  argument.Soltype.Type.String(),
+			Value: formatAbiValue(argument.Soltype.Type, argument.Value),
+		}
+	}
+
+	return formatted
+}
@@ -0,0 +1,105 @@
+package tracers
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/tenderly/tenderly-trace/ethereum/signer/accounts/abi"
+	"github.com/tenderly/tenderly-trace/ethereum/signer/accounts/signer/fourbyte"
+)
+
+// approveAbi knows neither transfer nor Transfer, like the ABI of a proxy.
+const approveAbi = `[
+	{"type": "function", "name": "approve", "inputs": [{"name": "spender", "type": "address"}, {"name": "amount", "type": "uint256"}], "outputs": [{"name": "", "type": "bool"}]},
+	{"type": "event", "name": "Approval", "inputs": [{"name": "owner", "type": "address", "indexed": true}, {"name": "spender", "type": "address", "indexed": true}, {"name": "amount", "type": "uint256", "indexed": false}]}
+]`
+
+func withSignatures(t *testing.T, signatures ...string) {
+	t.Helper()
+
+	db := fourbyte.New()
+	for _, signature := range signatures {
+		if strings.Contains(signature, "Transfer") {
+			db.AddEvent(signature)
+		} else {
+			db.AddFunction(signature)
+		}
+	}
+
+	SetSignatures(db)
+	t.Cleanup(func() { SetSignatures(nil) })
+}
+
+func encode(t *testing.T, value interface{}) string {
+	t.Helper()
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("failed encoding: %s", err)
+	}
+
+	return string(data)
+}
+
+func TestDecodeCallInputSignatures(t *testing.T) {
+	contractAbi, err := abi.JSON(strings.NewReader(approveAbi))
+	if err != nil {
+		t.Fatalf("failed parsing ABI: %s", err)
+	}
+
+	input := hexutil.MustDecode("0xa9059cbb" +
+		"00000000000000000000000000000000000000000000000000000000000000bb" +
+		"0000000000000000000000000000000000000000000000000000000000000005")
+
+	if decoded := decodeCallInput(&contractAbi, input); decoded != nil {
+		t.Errorf("expected no decoding without signatures, got %s", encode(t, decoded))
+	}
+
+	withSignatures(t, "transfer(address,uint256)")
+
+	expected := `{"signature":"transfer(address,uint256)","name":"transfer","inputs":[` +
+		`{"name":"","type":"address","value":"` + common.HexToAddress("0xbb").Hex() + `"},` +
+		`{"name":"","type":"uint256","value":"5"}]}`
+	for _, candidate := range []*abi.ABI{nil, &contractAbi} {
+		if decoded := encode(t, decodeCallInput(candidate, input)); decoded != expected {
+			t.Errorf("unexpected decoding %s", decoded)
+		}
+	}
+
+	// The ABI takes precedence for the functions it has.
+	approve := append(hexutil.MustDecode("0x095ea7b3"), input[4:]...)
+	if decoded := decodeCallInput(&contractAbi, approve); decoded == nil || decoded.Name != "approve" || decoded.Inputs[0].Name != "spender" {
+		t.Errorf("expected approve decoded with the ABI, got %s", encode(t, decoded))
+	}
+}
+
+func TestDecodeLogSignatures(t *testing.T) {
+	contractAbi, err := abi.JSON(strings.NewReader(approveAbi))
+	if err != nil {
+		t.Fatalf("failed parsing ABI: %s", err)
+	}
+
+	topics := []common.Hash{
+		common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+		common.HexToAddress("0x00000000000000000000000000000000000000aa").Hash(),
+		common.HexToAddress("0x00000000000000000000000000000000000000bb").Hash(),
+	}
+	data := common.LeftPadBytes([]byte{0x05}, 32)
+
+	withSignatures(t, "Transfer(address,address,uint256)")
+
+	decoded := decodeLog(&contractAbi, topics, data)
+	if decoded == nil || decoded.Signature != "Transfer(address,address,uint256)" || len(decoded.Inputs) != 3 {
+		t.Fatalf("expected the log decoded with the signatures, got %s", encode(t, decoded))
+	}
+	if value := decoded.Inputs[2].Value; value != "5" {
+		t.Errorf("expected the amount decoded, got %v", value)
+	}
+
+	if decoded := decodeLog(&contractAbi, nil, data); decoded != nil {
+		t.Errorf("expected anonymous logs not decoded, got %s", encode(t, decoded))
+	}
+}
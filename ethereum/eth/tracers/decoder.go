@@ -0,0 +1,475 @@
+package tracers
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/tenderly/tenderly-trace/ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
+)
+
+const (
+	// maxDecodedLength bounds the number of elements decoded from a dynamic
+	// array, so a garbage length read from a dead stack slot doesn't stall
+	// the trace.
+	maxDecodedLength = 1024
+	// maxDecodedDepth bounds the nesting of decoded arrays and structs.
+	maxDecodedDepth = 16
+)
+
+// structField is a named member of a decoded struct.
+type structField struct {
+	Name  string
+	Value interface{}
+}
+
+// structValue is a decoded struct. It marshals to a JSON object keeping the
+// declaration order of the members.
+type structValue []structField
+
+func (s structValue) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range s {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		name, err := json.Marshal(field.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(field.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// storageReference is a variable kept in storage, reported by its slot.
+type storageReference struct {
+	Slot string `json:"slot"`
+}
+
+// variableDecoder reads the values of Solidity variables from the stack,
+// memory and calldata of the step being traced. Variables are located by the
+// position of their topmost stack slot, counted from the top of the stack.
+type variableDecoder struct {
+	stack    *vm.Stack
+	memory   *vm.Memory
+	contract *vm.Contract
+	storage  *storageDecoder
+}
+
+// stackSize returns the number of stack slots a variable of the given type
+// takes. Dynamic calldata arrays are kept as their offset and length, and
+// external function pointers as their address and selector.
+func stackSize(t *types.SolType) int {
+	switch {
+	case t.Location == types.CalldataLocation && (t.IsDynamicArray() || t.Kind == types.BytesKind || t.Kind == types.StringKind):
+		return 2
+	case t.Kind == types.FunctionKind && strings.HasPrefix(t.Identifier, "t_function_external"):
+		return 2
+	}
+
+	return 1
+}
+
+// decode returns the value of the variable of the given type whose topmost
+// stack slot is at the given position. Values which can't be read are nil.
+func (d *variableDecoder) decode(t *types.SolType, position int) interface{} {
+	word := d.peek(position)
+	if word == nil {
+		return nil
+	}
+
+	if t.Kind == types.FunctionKind && stackSize(t) == 2 {
+		address := d.peek(position + 1)
+		if address == nil {
+			return nil
+		}
+
+		selector := common.LeftPadBytes(word.Bytes(), 4)
+		return hexutil.Encode(append(common.BigToAddress(address).Bytes(), selector[len(selector)-4:]...))
+	}
+
+	if !t.IsReference() {
+		return decodeValue(t, word, d.contract.Definitions)
+	}
+
+	switch t.Location {
+	case types.MemoryLocation:
+		return d.decodeMemory(t, word, 0)
+	case types.CalldataLocation:
+		if stackSize(t) == 2 {
+			offset := d.peek(position + 1)
+			if offset == nil || !word.IsInt64() || !offset.IsInt64() {
+				return nil
+			}
+
+			return d.decodeCalldataSequence(t, offset.Int64(), word.Int64(), 0)
+		}
+
+		if !word.IsInt64() {
+			return nil
+		}
+		return d.decodeCalldata(t, word.Int64(), 0)
+	case types.StorageLocation:
+		// Storage pointers are decoded when their type is part of the storage
+		// layout, otherwise only the slot they point to is reported.
+		if d.storage != nil {
+			if value := d.storage.decodePointer(t, word); value != nil {
+				return value
+			}
+		}
+		return &storageReference{Slot: hexutil.EncodeBig(word)}
+	}
+
+	return nil
+}
+
+// decodeValue decodes a value type from a word, which is how value types are
+// kept on the stack, in memory and in calldata alike.
+func decodeValue(t *types.SolType, word *big.Int, definitions types.Definitions) interface{} {
+	switch t.Kind {
+	case types.UintKind:
+		return new(big.Int).And(word, mask(t.Size)).String()
+	case types.IntKind:
+		value := new(big.Int).And(word, mask(t.Size))
+		if value.Bit(t.Size-1) == 1 {
+			value.Sub(value, new(big.Int).Lsh(big.NewInt(1), uint(t.Size)))
+		}
+		return value.String()
+	case types.BoolKind:
+		return word.Sign() != 0
+	case types.AddressKind, types.ContractKind:
+		return common.BigToAddress(word).Hex()
+	case types.FixedBytesKind:
+		// Fixed size byte arrays are left aligned.
+		return hexutil.Encode(common.BigToHash(word).Bytes()[:t.Size])
+	case types.EnumKind:
+		if definition := definitions[t.Id]; definition != nil && word.IsInt64() &&
+			word.Int64() < int64(len(definition.Members)) {
+			return definition.Members[word.Int64()].Name
+		}
+		return word.String()
+	case types.FunctionKind:
+		return hexutil.EncodeBig(word)
+	}
+
+	return nil
+}
+
+// decodeMemory decodes a reference type kept in memory at the given pointer.
+// Array elements and struct members of reference types are pointers to their
+// own data.
+func (d *variableDecoder) decodeMemory(t *types.SolType, pointer *big.Int, depth int) interface{} {
+	if depth > maxDecodedDepth || !pointer.IsInt64() {
+		return nil
+	}
+	offset := pointer.Int64()
+
+	switch t.Kind {
+	case types.BytesKind, types.StringKind:
+		length := d.memoryWord(offset)
+		if length == nil || !length.IsInt64() {
+			return nil
+		}
+
+		data := d.memorySlice(offset+32, length.Int64())
+		if data == nil {
+			return nil
+		}
+		return encodeBytes(t, data)
+	case types.ArrayKind:
+		length := int64(t.Length)
+		if t.IsDynamicArray() {
+			word := d.memoryWord(offset)
+			if word == nil || !word.IsInt64() {
+				return nil
+			}
+			length = word.Int64()
+			offset += 32
+		}
+		if length > maxDecodedLength {
+			return nil
+		}
+
+		elem := t.Elem.WithLocation(types.MemoryLocation)
+		values := make([]interface{}, 0, length)
+		for i := int64(0); i < length; i++ {
+			values = append(values, d.decodeMemoryWord(elem, offset+i*32, depth))
+		}
+		return values
+	case types.StructKind:
+		definition := d.contract.Definitions[t.Id]
+		if definition == nil {
+			return nil
+		}
+
+		value := structValue{}
+		for _, member := range definition.Members {
+			memberType, err := types.ParseTypeIdentifier(member.TypeName.TypeDescription.TypeIdentifier)
+			// Mappings are left out of structs kept in memory.
+			if err != nil || memberType.Kind == types.MappingKind {
+				continue
+			}
+
+			value = append(value, structField{
+				Name:  member.Name,
+				Value: d.decodeMemoryWord(memberType.WithLocation(types.MemoryLocation), offset, depth),
+			})
+			offset += 32
+		}
+		return value
+	}
+
+	return nil
+}
+
+// decodeMemoryWord decodes the memory word at the given offset, which is
+// either a value or a pointer to the data of a reference type.
+func (d *variableDecoder) decodeMemoryWord(t *types.SolType, offset int64, depth int) interface{} {
+	word := d.memoryWord(offset)
+	if word == nil {
+		return nil
+	}
+
+	if t.IsReference() {
+		return d.decodeMemory(t, word, depth+1)
+	}
+
+	return decodeValue(t, word, d.contract.Definitions)
+}
+
+// decodeCalldata decodes the ABI encoded value starting at the given offset
+// of the call input.
+func (d *variableDecoder) decodeCalldata(t *types.SolType, offset int64, depth int) interface{} {
+	if depth > maxDecodedDepth {
+		return nil
+	}
+
+	switch t.Kind {
+	case types.BytesKind, types.StringKind:
+		length := d.calldataWord(offset)
+		if length == nil || !length.IsInt64() {
+			return nil
+		}
+		return d.decodeCalldataSequence(t, offset+32, length.Int64(), depth)
+	case types.ArrayKind:
+		if t.IsDynamicArray() {
+			length := d.calldataWord(offset)
+			if length == nil || !length.IsInt64() {
+				return nil
+			}
+			return d.decodeCalldataSequence(t, offset+32, length.Int64(), depth)
+		}
+		return d.decodeCalldataSequence(t, offset, int64(t.Length), depth)
+	case types.StructKind:
+		definition := d.contract.Definitions[t.Id]
+		if definition == nil {
+			return nil
+		}
+
+		var names []string
+		var members []*types.SolType
+		for _, member := range definition.Members {
+			memberType, err := types.ParseTypeIdentifier(member.TypeName.TypeDescription.TypeIdentifier)
+			if err != nil {
+				return nil
+			}
+
+			names = append(names, member.Name)
+			members = append(members, memberType.WithLocation(types.CalldataLocation))
+		}
+
+		value := structValue{}
+		for i, member := range d.decodeCalldataTuple(members, offset, depth) {
+			value = append(value, structField{Name: names[i], Value: member})
+		}
+		return value
+	}
+
+	word := d.calldataWord(offset)
+	if word == nil {
+		return nil
+	}
+	return decodeValue(t, word, d.contract.Definitions)
+}
+
+// decodeCalldataSequence decodes the data of a byte array, or the elements of
+// an array, whose encoding starts at the given offset of the call input.
+func (d *variableDecoder) decodeCalldataSequence(t *types.SolType, offset, length int64, depth int) interface{} {
+	if t.Kind == types.BytesKind || t.Kind == types.StringKind {
+		data := d.calldataSlice(offset, length)
+		if data == nil {
+			return nil
+		}
+		return encodeBytes(t, data)
+	}
+
+	if length > maxDecodedLength {
+		return nil
+	}
+
+	elems := make([]*types.SolType, length)
+	for i := range elems {
+		elems[i] = t.Elem.WithLocation(types.CalldataLocation)
+	}
+
+	return d.decodeCalldataTuple(elems, offset, depth+1)
+}
+
+// decodeCalldataTuple decodes the ABI encoded tuple starting at the given
+// offset. Dynamic members are encoded as offsets relative to its start.
+func (d *variableDecoder) decodeCalldataTuple(members []*types.SolType, offset int64, depth int) []interface{} {
+	values := make([]interface{}, 0, len(members))
+
+	head := offset
+	for _, member := range members {
+		if isDynamicABIType(member, d.contract.Definitions) {
+			relative := d.calldataWord(head)
+			if relative == nil || !relative.IsInt64() {
+				values = append(values, nil)
+			} else {
+				values = append(values, d.decodeCalldata(member, offset+relative.Int64(), depth+1))
+			}
+		} else {
+			values = append(values, d.decodeCalldata(member, head, depth+1))
+		}
+
+		head += abiHeadSize(member, d.contract.Definitions)
+	}
+
+	return values
+}
+
+func (d *variableDecoder) peek(position int) *big.Int {
+	data := d.stack.Data()
+	if position < 0 || position >= len(data) {
+		return nil
+	}
+
+	return data[len(data)-position-1]
+}
+
+func (d *variableDecoder) memoryWord(offset int64) *big.Int {
+	data := d.memorySlice(offset, 32)
+	if data == nil {
+		return nil
+	}
+
+	return new(big.Int).SetBytes(data)
+}
+
+// memorySlice returns the memory in the range, or nil if it is out of bounds.
+// Offsets and sizes are read from the execution, so they can be arbitrarily
+// large and are compared without adding them up.
+func (d *variableDecoder) memorySlice(offset, size int64) []byte {
+	if offset < 0 || size < 0 || size > int64(d.memory.Len())-offset {
+		return nil
+	}
+	if size == 0 {
+		return []byte{}
+	}
+
+	return d.memory.Get(offset, size)
+}
+
+func (d *variableDecoder) calldataWord(offset int64) *big.Int {
+	data := d.calldataSlice(offset, 32)
+	if data == nil {
+		return nil
+	}
+
+	return new(big.Int).SetBytes(data)
+}
+
+// calldataSlice returns the call input in the range, or nil if it is out of
+// bounds.
+func (d *variableDecoder) calldataSlice(offset, size int64) []byte {
+	if offset < 0 || size < 0 || size > int64(len(d.contract.Input))-offset {
+		return nil
+	}
+
+	return d.contract.Input[offset : offset+size]
+}
+
+// isDynamicABIType reports whether the ABI encoding of the type is kept out
+// of the head of its enclosing tuple.
+func isDynamicABIType(t *types.SolType, definitions types.Definitions) bool {
+	switch t.Kind {
+	case types.BytesKind, types.StringKind:
+		return true
+	case types.ArrayKind:
+		return t.IsDynamicArray() || isDynamicABIType(t.Elem, definitions)
+	case types.StructKind:
+		definition := definitions[t.Id]
+		if definition == nil {
+			return false
+		}
+
+		for _, member := range definition.Members {
+			memberType, err := types.ParseTypeIdentifier(member.TypeName.TypeDescription.TypeIdentifier)
+			if err == nil && isDynamicABIType(memberType, definitions) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// abiHeadSize returns the number of bytes the type takes in the head of its
+// enclosing tuple.
+func abiHeadSize(t *types.SolType, definitions types.Definitions) int64 {
+	if isDynamicABIType(t, definitions) {
+		return 32
+	}
+
+	switch t.Kind {
+	case types.ArrayKind:
+		return int64(t.Length) * abiHeadSize(t.Elem, definitions)
+	case types.StructKind:
+		definition := definitions[t.Id]
+		if definition == nil {
+			return 32
+		}
+
+		var size int64
+		for _, member := range definition.Members {
+			memberType, err := types.ParseTypeIdentifier(member.TypeName.TypeDescription.TypeIdentifier)
+			if err != nil {
+				continue
+			}
+			size += abiHeadSize(memberType, definitions)
+		}
+		return size
+	}
+
+	return 32
+}
+
+func encodeBytes(t *types.SolType, data []byte) interface{} {
+	if t.Kind == types.StringKind {
+		return string(data)
+	}
+
+	return hexutil.Encode(data)
+}
+
+// mask returns a mask of the lowest bits of a word.
+func mask(bits int) *big.Int {
+	return new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(bits)), big.NewInt(1))
+}
@@ -0,0 +1,112 @@
+package tracers
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tenderly/tenderly-trace/ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
+)
+
+func parseType(t *testing.T, identifier string) *types.SolType {
+	t.Helper()
+
+	solType, err := types.ParseTypeIdentifier(identifier)
+	if err != nil {
+		t.Fatalf("failed parsing %s: %s", identifier, err)
+	}
+
+	return solType
+}
+
+// A length word as large as an int64 gets must not wrap around the bounds
+// checks of the data it is the length of.
+func TestDecodeHugeLength(t *testing.T) {
+	huge := common.LeftPadBytes(new(big.Int).SetInt64(math.MaxInt64).Bytes(), 32)
+
+	memory := vm.NewMemory()
+	memory.Resize(64)
+	memory.Set(0, 32, huge)
+
+	d := &variableDecoder{
+		memory:   memory,
+		contract: &vm.Contract{Input: append(huge, make([]byte, 32)...)},
+	}
+
+	if value := d.decodeMemory(parseType(t, "t_string_memory_ptr"), big.NewInt(0), 0); value != nil {
+		t.Errorf("expected no memory string, got %v", value)
+	}
+	if value := d.decodeCalldata(parseType(t, "t_bytes_calldata_ptr"), 0, 0); value != nil {
+		t.Errorf("expected no calldata bytes, got %v", value)
+	}
+	if value := d.decodeCalldataSequence(parseType(t, "t_bytes_calldata_ptr"), 32, math.MaxInt64, 0); value != nil {
+		t.Errorf("expected no calldata sequence, got %v", value)
+	}
+
+	if data := d.memorySlice(math.MaxInt64, 32); data != nil {
+		t.Errorf("expected no memory at the end of the address space, got %x", data)
+	}
+	if data := d.calldataSlice(32, 32); len(data) != 32 {
+		t.Errorf("expected a calldata word within bounds, got %x", data)
+	}
+}
+
+// solcLayout is the storageLayout solc reports for
+//
+//	struct Order { uint128 amount; bool filled; address maker; }
+//	Order[] orders;
+const solcLayout = `{
+	"storage": [
+		{"astId": 9, "contract": "Book.sol:Book", "label": "orders", "offset": 0, "slot": "0", "type": "t_array(t_struct(Order)7_storage)dyn_storage"}
+	],
+	"types": {
+		"t_address": {"encoding": "inplace", "label": "address", "numberOfBytes": "20"},
+		"t_array(t_struct(Order)7_storage)dyn_storage": {"base": "t_struct(Order)7_storage", "encoding": "dynamic_array", "label": "struct Book.Order[]", "numberOfBytes": "32"},
+		"t_bool": {"encoding": "inplace", "label": "bool", "numberOfBytes": "1"},
+		"t_struct(Order)7_storage": {"encoding": "inplace", "label": "struct Book.Order", "numberOfBytes": "64", "members": [
+			{"astId": 2, "contract": "Book.sol:Book", "label": "amount", "offset": 0, "slot": "0", "type": "t_uint128"},
+			{"astId": 4, "contract": "Book.sol:Book", "label": "filled", "offset": 16, "slot": "0", "type": "t_bool"},
+			{"astId": 6, "contract": "Book.sol:Book", "label": "maker", "offset": 0, "slot": "1", "type": "t_address"}
+		]},
+		"t_uint128": {"encoding": "inplace", "label": "uint128", "numberOfBytes": "16"}
+	}
+}`
+
+// Storage pointers are decoded with layouts reported by the compiler, which
+// key their types differently from the type identifiers of the AST.
+func TestDecodeStoragePointer(t *testing.T) {
+	var layout types.StorageLayout
+	if err := json.Unmarshal([]byte(solcLayout), &layout); err != nil {
+		t.Fatalf("failed parsing layout: %s", err)
+	}
+
+	address := common.HexToAddress("0xaa")
+	maker := common.HexToAddress("0xbb")
+	db := newMemoryState(nil)
+	db.SetState(address, common.BigToHash(big.NewInt(5)), common.HexToHash("0x01"+"00000000000000000000000000000007"))
+	db.SetState(address, common.BigToHash(big.NewInt(6)), maker.Hash())
+
+	d := &storageDecoder{db: db, address: address, layout: &layout}
+
+	value := d.decodePointer(parseType(t, "t_struct$_Order_$7_storage_ptr"), big.NewInt(5))
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("failed encoding: %s", err)
+	}
+	expected := `{"amount":"7","filled":true,"maker":"` + maker.Hex() + `"}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+
+	db.SetState(address, common.Hash{}, common.BigToHash(big.NewInt(1)))
+	if value := d.decodePointer(parseType(t, "t_array$_t_struct$_Order_$7_storage_$dyn_storage_ptr"), big.NewInt(0)); value == nil {
+		t.Errorf("expected the array of orders decoded")
+	}
+
+	if value := d.decodePointer(parseType(t, "t_struct$_Other_$8_storage_ptr"), big.NewInt(5)); value != nil {
+		t.Errorf("expected a struct missing from the layout not decoded, got %v", value)
+	}
+}
@@ -69,7 +69,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _call_tracer_finalJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x5c\xeb\x6f\xe3\x36\xb6\xff\x9e\xbf\xe2\x4c\x3e\x74\x62\xc4\xb1\x33\xd3\xdd\x5e\xc0\xd9\xcc\x45\x6e\x26\xd3\xa6\x48\x27\x41\xe2\x69\x51\x04\xc1\x05\x2d\x1d\xd9\x9c\xc8\xa4\x96\xa4\xec\xf8\xb6\xf9\xdf\x2f\xf8\x90\xac\x07\x25\xd9\x4e\xa6\xd8\x5d\x7d\xb1\x25\x1d\x1e\x3e\xce\x83\xbf\x43\x1e\x6a\x38\x84\x73\x9e\xac\x04\x9d\xce\x14\xbc\x3f\x7e\xf7\x5f\x30\x9e\x21\x4c\xf9\x11\xaa\x19\x0a\x4c\xe7\x70\x96\xaa\x19\x17\x72\x6f\x38\x84\xf1\x8c\x4a\x88\x68\x8c\x40\x25\x24\x44\x28\xe0\x11\xa8\x0a\x7d\x4c\x27\x82\x88\xd5\x60\x6f\x38\xb4\x65\xbc\xaf\x35\x87\x48\x20\x82\xe4\x91\x5a\x12\x81\x23\x58\xf1\x14\x02\xc2\x40\x60\x48\xa5\x12\x74\x92\x2a\x04\xaa\x80\xb0\x70\xc8\x05\xcc\x79\x48\xa3\x95\x66\x49\x15\xa4\x2c\x44\x61\xaa\x56\x28\xe6\x32\x6b\xc7\x8f\x9f\xbf\xc0\x15\x4a\x89\x02\x7e\x44\x86\x82\xc4\x70\x93\x4e\x62\x1a\xc0\x15\x0d\x90\x49\x04\x22\x21\xd1\x4f\xe4\x0c\x43\x98\x18\x76\xba\xe0\x27\xdd\x94\x3b\xd7\x14\xf8\xc4\x53\x16\x12\x45\x39\xeb\x03\x52\xdd\x72\x58\xa0\x90\x94\x33\xf8\x3e\xab\xca\x31\xec\x03\x17\x9a\xc9\x01\x51\xba\x03\x02\x78\xa2\xcb\xf5\x80\xb0\x15\xc4\x44\xad\x8b\x6e\x30\x20\xeb\x7e\x87\x40\x99\xa9\x66\xc6\x13\x04\x35\x23\x4a\xf7\x7a\x49\xe3\x18\x26\x08\xa9\xc4\x28\x8d\xfb\x9a\xdb\x24\x55\xf0\xdb\xe5\xf8\xa7\xeb\x2f\x63\x38\xfb\xfc\x3b\xfc\x76\x76\x7b\x7b\xf6\x79\xfc\xfb\x09\x2c\xa9\x9a\xf1\x54\x01\x2e\xd0\xb2\xa2\xf3\x24\xa6\x18\xc2\x92\x08\x41\x98\x5a\x01\x8f\x34\x87\x5f\x2e\x6e\xcf\x7f\x3a\xfb\x3c\x3e\xfb\x9f\xcb\xab\xcb\xf1\xef\xc0\x05\x7c\xba\x1c\x7f\xbe\xb8\xbb\x83\x4f\xd7\xb7\x70\x06\x37\x67\xb7\xe3\xcb\xf3\x2f\x57\x67\xb7\x70\xf3\xe5\xf6\xe6\xfa\xee\x62\x00\x77\xa8\x5b\x85\xba\x7c\xf7\x98\x47\x46\x7a\x02\x21\x44\x45\x68\x2c\xb3\x91\xf8\x9d\xa7\x20\x67\x3c\x8d\x43\x98\x91\x05\x82\xc0\x00\xe9\x02\x43\x20\x10\xf0\x64\xb5\xb1\x50\x35\x2f\x12\x73\x36\x35\x7d\x6e\x54\x48\xb8\x8c\x80\x71\xd5\x07\x89\x08\xff\x98\x29\x95\x8c\x86\xc3\xe5\x72\x39\x98\xb2\x74\xc0\xc5\x74\x18\x5b\x76\x72\xf8\x61\xb0\xa7\x79\x06\x24\x8e\xc7\x82\x04\x28\xb4\x70\x08\x44\xa9\x1e\xfe\x98\x2f\x19\x28\x41\x98\x24\x81\x16\xb5\xfe\x1f\x18\x65\x24\x0a\xf0\x49\xdf\x29\xa9\x95\x16\x04\x26\x5c\xe8\xff\x71\x9c\xe9\x19\x65\x0a\x05\x23\xb1\xe1\x2d\x61\x4e\x42\x84\xc9\x0a\x48\x91\x61\xbf\xd8\x19\xad\x46\x56\xdc\x40\x59\xc4\xc5\xdc\xa8\xe5\x60\xef\x8f\x3d\x00\x00\xd7\x48\xa9\x48\xf0\xa8\xdb\xa8\xab\x08\x52\x21\x90\x29\x3d\x9a\xa9\x90\x74\x81\x86\x04\x2c\x8d\x1b\xd2\x8b\x5f\x7f\x01\x7c\xc2\x20\xb5\xcc\x34\xab\x9c\xcf\x08\xee\x1f\xfa\x7b\xe6\xd9\x1c\xd5\x8c\x87\x1f\x31\x51\xb3\x91\x7e\xa8\x9f\x7d\x4d\xe7\x49\x88\x52\xfd\x62\xde\xd5\x1e\x5f\x32\xaa\x3e\xa5\xcc\x74\xc4\x72\xaa\xbe\xbd\x74\x43\x30\x82\x88\xc4\x12\xeb\x04\x23\x50\x22\x75\xcf\x63\x1e\x90\xf8\x57\x22\x28\x99\xc4\x28\xf3\xda\xa4\x22\x0a\xf3\xc7\xba\x10\x25\x0a\xc3\x75\x85\x65\x82\xbc\x5c\x22\x70\x71\x73\x3e\x82\x63\xd7\xc1\xe1\x10\x42\x94\x01\xb2\x10\x43\x23\xc7\x47\x09\xcb\x99\xd1\x1c\x58\xe2\xdb\x05\xc2\xd7\x54\xaa\x02\x4d\x24\xf8\x1c\x08\x03\x9e\x6a\xcb\x2e\x6a\x01\x65\x8a\x67\x3c\x89\xbe\x65\x28\xcc\xa0\xda\xe1\xcd\x59\x8c\x5c\xaf\x33\x5a\xa9\x30\xd1\xa2\xa3\x6c\xc1\x1f\x75\x0d\x5c\x68\x93\x15\x2b\xe0\x49\xc0\x43\x67\xfc\x5a\x68\xb9\xcc\x50\x0e\x5c\x27\x31\x19\x59\x46\x91\x1b\x72\x38\x88\xf9\xb4\x0f\xe1\xa4\x07\x56\x43\x5c\x2d\xe7\x24\x51\xa9\x40\xa3\x4e\x28\x04\x17\x12\xe8\x7c\x8e\xa1\x1e\xb6\x78\x95\x53\x2e\x88\xb0\xaf\xe1\x14\x62\x3e\x1d\x4c\x51\x5d\xe8\xdb\x83\xde\x49\x4e\x43\x23\x38\xb0\x34\x6f\x4e\x4f\x8d\x23\x8e\x28\xc3\xb0\x58\xa1\xbe\xd4\x8c\xca\x41\x44\xd2\x58\xe5\x4d\x3a\x29\x11\x08\x54\xa9\x60\xeb\x67\xcf\x7b\xa5\x2a\x4c\xf9\xb2\xb2\xdd\x2b\xfe\x13\x3e\x69\x76\x83\x80\x33\x63\x68\xba\x85\x67\x61\x28\x50\xca\x83\x5e\xef\x01\x4e\x4f\x81\xa5\x71\xec\x6d\xcc\xf6\xcc\xe0\xfe\xa1\xa9\x79\x6f\x0c\xcb\x06\x3d\xec\xe6\xed\x6d\x60\x99\xdb\xb6\x0d\xcc\xe4\x27\x17\x3f\x4b\xce\xd6\x02\xbc\x2b\x71\x3d\xa8\x48\x41\xf7\xc5\x95\x78\x73\x0a\xfb\x7a\xf0\xf6\xab\x8d\x5b\x73\x86\x53\xf8\xf9\xee\xfa\xf3\x20\x21\x42\xa2\x2b\x57\x61\x58\x18\xa7\xac\x1c\x65\x21\x3e\xc1\x29\x1c\x97\x29\xb5\xa6\x1f\x98\xf7\xe6\x1d\x50\xf8\x07\xc8\xc5\x20\x46\x36\x55\xb3\x13\xa0\x87\x87\xbe\x86\xc8\x25\x55\xc1\x4c\x37\xfa\x9e\x3e\x0c\xd4\x2a\xc1\xcf\x64\x8e\xe6\xcf\x47\x94\x81\xa0\x66\xfe\x35\xf7\x97\x21\x32\x45\x23\x8a\xc2\xc7\x08\x8c\xd3\x93\x08\xfb\xea\x7f\x53\xca\xd4\xfb\xbf\xff\xb0\x3f\xf2\x52\xed\x2c\xa0\x41\x92\xca\xd9\x81\xbf\xea\xec\x62\x64\x8e\x23\xb0\xbd\xd1\xff\xfb\xad\xd4\x0b\x12\xa7\x38\x82\x75\xcd\xe1\x24\x97\x71\x73\x4b\xfa\x56\x04\xbd\x5e\x3b\x73\x43\x34\xb2\x3f\xcd\x94\xcf\x15\x81\xd7\x38\x1c\x1e\x36\x13\x4c\x04\x92\xc7\xfa\xeb\xe7\x36\x15\x7a\x99\xa5\xc1\xa9\x99\x4e\x4e\xd6\x3c\x9f\x01\x63\x89\x15\x8d\xf0\x29\xe3\x6e\x32\xef\xd0\xdf\xa2\x41\xec\x54\x81\x56\x14\xc3\xa0\x3e\x8c\x3b\xf3\x33\x6a\xa5\x1b\xb4\xa3\x5e\x55\x5d\x80\x47\x92\xc3\x21\x4c\x39\x68\x48\xaa\x23\x8d\x0c\xa4\x64\x6c\x0d\x5e\xb2\x70\xa3\xaf\x41\x9a\xc6\x4c\x0e\xac\xcc\xf7\x6a\x32\xfa\x6a\xd1\x31\x95\x83\x32\x3e\xd8\xde\xef\xe6\x2c\x1f\x77\x66\x79\xff\xb5\xc6\x15\x8a\x73\xd8\x0e\xfc\xee\x1f\x1f\x06\x09\x97\xd4\xcc\xe8\x1f\x72\x4f\x7e\x9d\x9c\xf3\x10\xef\x34\x48\x3b\x9f\x11\x36\xc5\x57\xa9\xa0\x07\xdf\x7d\xb7\x73\xcf\x35\xa3\x98\x46\xa8\xe8\x1c\x9b\x9c\xec\x4b\x78\x67\x8a\xa9\x29\x0d\x3a\x1d\x24\x88\x8f\xaf\xd3\xef\x57\x6f\x6c\x2e\xb2\xa3\x6f\x2d\xb2\xba\x03\xf5\xf9\xb4\xd7\xe8\x53\x26\x5c\x38\xb5\x70\xbd\xdb\x77\xd7\xff\x19\xec\xb0\x92\x26\x0c\x39\x35\x08\x75\xc0\x93\x81\xe2\x9f\xd3\xf9\x04\xc5\x41\x0f\xbe\x83\xe3\xa7\xe8\xb8\xa7\xd1\x9b\xfe\x73\x52\x2a\xc9\x13\x27\x7f\x53\xe6\x4e\x09\xca\xa6\x45\x14\xa3\x69\x92\x60\x8d\x77\x6e\xce\xab\x6f\x89\x54\x65\x48\x74\x26\xd5\x41\x12\x54\x30\x6d\x46\xd5\x08\x83\x1c\xab\x32\x06\x72\xa5\x7a\x8d\x38\x76\x37\x20\x7b\x9f\x04\x0f\xba\x25\xba\x21\xda\x3e\x4d\xbd\x19\xb6\xfd\xf3\xcf\x32\xa0\x2d\xc6\x5c\x9b\x61\xe4\x24\xa8\x76\xce\x76\x6c\xe7\xb6\x9e\xd4\x51\x1f\x4f\x55\x92\x6a\xa6\xfb\xfb\x27\xb5\x97\x21\xea\xd8\x26\xbc\xce\x68\xee\x1f\xea\xb0\x94\x48\x35\xb0\x41\xc2\x0d\x11\x64\x8e\x0a\x85\xd4\xa3\xee\xfe\x66\xc3\xd3\x88\x56\xb5\xd1\xdd\x64\x26\x59\x43\x9f\x9b\xb6\x04\x1a\xc0\x41\x47\xeb\xba\x60\x00\x14\xa0\x6c\x07\xab\x57\xc0\xb9\xb0\x15\xd6\xd5\x97\x93\xde\xe1\x69\xfe\x0f\xa4\x31\xbd\x71\xae\x0e\x05\x7f\x5c\x1a\xeb\x5e\xc1\x4c\x5b\xb0\x22\x98\xa8\xb8\x30\xf8\x9b\xa0\x65\xc8\x11\xf3\x06\x83\xd6\x0d\xa7\x61\x0d\xa9\x77\xea\x5d\x3b\xf7\x36\xa4\x0c\x76\x91\x62\xcd\xb7\x0d\x35\x43\x33\x72\x86\x9a\x07\xae\x3f\x79\xae\x99\x96\xb1\xf3\x7c\xc5\xc7\xe9\x2a\x7c\x80\x77\x3e\x05\x72\x7e\xbb\x52\x26\xe1\x49\x35\x96\xcc\x88\x07\xb9\xe9\xdb\x3f\x0d\x54\x55\xcb\x2b\xdd\x37\x94\x31\xb3\x98\x2c\xbb\x60\xf3\xd7\x8a\x8f\x46\xab\x1d\x27\x58\xc3\x3d\x4a\x59\xf0\xe0\xd3\x59\xf3\xb6\x0c\xac\xbb\xdb\xb0\x2d\x10\xf7\x55\x5c\x5b\x09\x79\x0d\x5f\x9f\x5d\x55\x89\x6a\xf3\xd3\xb7\x5b\xc2\x0b\xed\x18\x63\x8c\x54\x5d\x47\x9c\x0f\xf4\x96\xaa\xab\xe1\xbd\x61\x72\x04\xef\x1e\xec\x33\x38\x6d\x5b\x5f\x6a\xee\x48\x9d\x8f\xd7\xa9\x83\xd7\x72\xb2\xa6\x19\x89\x4f\x89\x6c\x5f\xe4\x2a\x5e\x59\x89\x2f\x12\x43\x38\x85\xb7\xc7\x4f\x6f\xe1\x10\x26\x74\x7a\xc9\xd4\x9a\xdd\x51\x4e\x77\xce\xa5\x6e\xa5\x83\x24\x3f\x12\x2d\xb0\xb5\x6f\x79\xf7\x43\x83\xf3\xf0\x37\x39\xc4\x18\x15\xe6\xbc\x2f\x99\xbf\x70\x85\x4c\x37\xa1\x59\x3a\xbb\x0f\x41\x63\xf7\x37\xeb\xe0\x06\xca\x5a\x95\x71\x41\x79\xfd\x5c\x4d\xf1\xc2\x7a\x76\xb7\xed\x1c\x1d\xf9\xb0\x6e\xd9\xb1\x36\x99\x45\xa5\xb1\xc7\x0f\xdd\x2e\xb1\x5e\x64\x4b\xff\x58\x67\xf0\xcd\x9c\xa5\x9e\x7b\xf5\xe4\xea\x75\x59\xf5\x76\xfc\xd5\x8e\xf3\xb9\xba\xd4\xe3\xd0\x64\x13\x62\xd4\xaf\x75\x94\x71\x0a\xfb\x3f\x7f\xf9\xe5\xe6\xe3\xc5\xdd\x78\x5f\x83\xee\x24\xd0\x53\xe2\xf1\x71\x0f\xfe\x80\xe1\x10\xd2\x69\xbc\x82\xd1\x81\xd7\x47\x9b\x11\xe1\x21\x8e\x57\x09\x1a\x46\x99\x8f\xfe\xa8\xad\xc6\x4c\xf0\xde\x65\xd5\x62\xf9\x64\x5b\x94\x9b\x5d\x76\x49\xc9\x0f\xb6\xab\x74\x4e\x89\x2e\x59\x33\xda\x2d\x92\x6f\x00\xa2\xb3\xab\x04\x92\x1b\xfb\x93\x61\x8d\x23\x78\xa7\x71\xf4\x07\x8b\xa7\x8f\x8e\xda\xfa\x07\x15\xac\xec\x65\xfc\x4a\x28\x39\xbb\xb6\x43\xcb\xd9\x65\xa5\x70\x78\xfa\x2d\xb0\x72\x76\x15\x25\xb8\x29\x64\xce\xae\x35\x74\x6e\x1c\xc3\xcd\x40\x73\x76\x7d\x3b\xf0\x9c\x5d\x5d\x20\x3a\xbb\xb6\x02\xd3\xd9\xd5\x02\xaa\xf3\xfa\x9b\x17\xc2\xb7\x99\xbe\xbc\x40\xae\x4d\x11\x9b\x61\xfa\xe9\x29\x1c\x77\xa9\xb0\xb6\x43\x87\xdb\xbb\xb5\x43\xf1\xe2\x8e\x82\xdf\xc9\x76\x0b\xcb\xc0\x8e\x51\x09\xcf\x6c\x54\x48\x83\x90\xbc\x98\xbe\xd9\xa4\x9c\x36\xe8\x11\xec\x9f\x9f\x5d\x5d\xed\x77\x04\x61\xcd\xe2\x6d\x45\xb5\xd9\x55\x18\xc9\xcd\x22\xa0\x9c\x7b\x3b\x4c\x32\x4b\x56\x49\xd0\x5c\x3e\x8f\x48\x9c\x3b\xd5\x86\xd9\x41\x9d\x4d\x02\xe6\xb7\x83\xb6\x32\x11\x14\x6f\x9b\x4b\xbe\x28\x14\xb1\xa5\xed\x16\x3c\x1c\xc2\xbb\x8e\x6a\xb6\x02\x6b\xcd\x53\x53\xa7\x90\x37\x36\x95\x24\x18\x41\x12\xb4\x6b\x9b\x96\xd7\x28\x17\x57\x3b\xad\xd3\xe1\x1c\x72\x74\x70\x16\x7c\xfe\x52\x33\x7d\xb9\xa1\x1b\xcd\x1a\xd9\x9f\x76\xca\xa2\x42\x8d\x4a\x77\xed\xe5\xb6\x76\x23\xbb\xb8\x90\x84\x08\x64\xea\xca\xe0\xe3\xd1\xab\xc3\xe3\x0a\x02\xf6\x7b\x71\x13\xbb\xb8\x05\x87\x96\xcd\xd6\xd7\x34\x92\xa6\x59\xb1\x2d\xe2\x2a\x1a\x7b\xf3\x1e\x40\x8d\x7c\xe3\xb5\xe3\x8a\x4f\x78\xb0\xce\xae\xa5\x06\xdf\x7a\xc5\x49\xc7\x9c\xbb\xad\x04\x9b\xd2\x48\x6a\x8d\xd9\x9e\x71\x35\x63\x63\x2d\x83\x57\xed\xc1\xb6\x3a\xf8\xcd\x7a\xbc\x7d\x43\x36\x1f\xa1\xae\x55\xce\x6a\x50\xf6\x36\x6b\xf7\x47\x0c\x62\x22\x4c\x26\xdd\xdb\xa6\xed\x83\x85\xa3\x6d\x9c\x16\xd6\x58\xba\xd9\xd5\x67\x1b\x76\x26\xe3\xcc\x47\xe0\xf0\x73\xc3\xdb\x6c\xf3\xad\x98\x11\x57\xea\xb2\x47\xf1\xff\x12\x29\x59\xdb\xcb\x86\xa8\xd7\x14\x7d\xe7\xff\x86\x43\xb8\x8c\x80\x00\xc3\xe5\x7a\xcb\x9f\x4a\x98\x20\x65\x53\x08\x04\x12\x85\x61\x1f\x48\x18\x82\xe2\x36\x8b\x31\xcf\x59\x2c\xed\xa6\x65\xdb\x88\xdf\x7d\x07\x36\x62\x7f\x7b\x7e\x7b\x71\x36\xbe\xa8\x89\xd1\xc6\xc4\xd7\x51\x54\xdf\x3c\x7e\xd7\xb3\xdb\xca\xd7\x51\x15\xbe\xd9\x42\x17\x2c\x34\x18\x4a\x17\x3e\xac\x16\x7e\x5f\x2a\x5c\x2a\x3d\x1c\xc2\x99\x94\x38\xd7\x4a\x53\xcb\xf5\x74\xc9\xa0\x26\xcf\x41\x2a\x2e\x6c\x56\x6c\xc0\xe7\x49\x8c\x5a\x43\x6a\xed\x68\x84\x24\x16\x36\xf0\xa4\xae\x0e\xbb\x42\x04\x37\xa9\xaf\x0b\xce\x71\xce\xc5\x6a\x20\x63\x1a\xe0\x81\x19\x88\xbe\x1d\x17\x5f\xe9\x8d\x26\xec\x4d\x27\x69\x67\x0f\x6e\xf5\xb0\x32\xf6\xc7\xe5\xe5\xc3\xb2\xd6\x95\x25\xd9\x32\x55\xd4\xc8\xd6\xd9\x9d\x36\x55\xa8\x44\x51\xcf\x52\xcc\xfe\x65\x3a\x5d\xd7\x67\x89\x71\x04\x7a\x16\x14\x69\x60\xf4\x7a\x4a\x4c\x46\xa9\xc9\xe4\x24\x12\x08\xc8\x74\x62\xe4\xab\x38\xef\x50\xef\xbb\x8b\xab\x4f\x1a\x1d\xde\x7e\x39\x1f\x7b\x95\x7c\xf3\x95\xf7\xd7\x58\x71\xdf\x7a\xa5\xfd\xb9\x45\x2c\xfe\x45\xdc\x3f\x32\x05\xaf\x46\xfe\x56\x14\x1d\xde\xc5\x02\x22\x93\x4d\x1b\x10\x9b\x98\x9b\x89\x25\xe4\x0c\xb7\xf4\x31\x07\x99\x93\x39\xbb\xba\x7a\x0b\x7f\xfe\x09\x85\xfb\xf3\xeb\x8f\x17\xc5\x67\x1f\x2f\xae\x2e\x7e\x3c\x1b\x5f\x54\x69\xef\xc6\x67\xe3\xcb\x73\xf3\xb4\x57\x1d\xd2\xe1\x10\xee\x1e\x69\x62\xd2\x72\x13\x81\x47\xda\x1f\x98\xe3\x15\x79\xf3\x65\x1f\xd4\x8c\x4b\x04\x22\x5c\x32\x72\x44\x58\x90\x25\x07\xcb\x9a\x3a\x28\x6e\xd2\xb8\x32\x73\xaf\xbb\xbe\xa2\x05\x79\x72\x41\xa9\xbc\x11\xe8\x9a\x11\x1e\x28\x5e\x6b\xb2\x47\x12\x75\x39\x9b\xfd\x7f\xe3\x7b\x0f\x36\x1f\x1d\xf8\x6f\x38\x86\x11\xbc\xab\xfa\xd5\x16\x57\xfe\x1e\x0e\x75\x3d\x2f\x71\xe8\xdf\x7b\x58\xfc\x67\xb8\xf5\x75\xb4\xa7\xf8\xbf\xb6\xd7\xe7\xa9\xba\x8e\xa2\x51\x55\x34\x7f\xab\x89\xc6\x5b\xf4\x0a\x59\xad\xe8\xdf\x37\x28\xfa\x2f\x16\x04\xb6\x4d\x67\x6e\x87\xe2\x4d\xcd\x90\xec\x44\xf1\xa6\xe2\x66\x1a\x36\xf1\xf3\x6c\x3a\xff\xf4\xfa\xbe\x6d\x77\xae\xcd\x8f\xbf\x60\x7a\x6d\x08\x62\x15\x5f\x2f\x18\x6d\x15\xe2\x7a\xea\xaf\x44\xb0\x36\x19\xb8\x48\xd5\x35\xc5\x7b\x0f\x82\x50\xa6\x78\xf9\x9c\x47\x5f\x33\x12\x14\x17\x08\x54\xbd\x95\xa6\x22\x20\x71\xcc\x97\x84\x05\x38\x80\xdf\xb0\xc8\x97\x21\x9a\x79\xc8\x1d\x18\xd2\x12\x36\x67\x4b\x96\x54\xcd\xdc\xd1\x2f\xe3\x2a\x88\x39\xd4\x23\x10\xe6\x64\x05\x13\x84\x28\x65\x8f\x2b\x6d\x58\x10\xae\x18\x99\xd3\x40\x16\xb9\x9a\x23\x43\x02\xa7\x44\x18\xe6\x02\xff\x99\xa2\x54\x18\x1a\xff\x44\x02\x95\x92\x38\x5e\xc1\x94\x2e\x90\x19\x1e\x07\xef\xbf\x3f\x3e\x06\xa9\x68\x82\x2c\xec\xc3\x0f\xdf\x0f\x7f\xf8\x1b\x88\x34\xc6\xde\xa0\x34\x25\x96\x05\xe9\xdb\x3f\x73\x16\x6e\xc4\x74\xd0\x83\x0f\x0d\x88\x04\x8e\x76\x10\xea\x06\x40\xe4\xf5\x2a\xb3\x36\x69\x37\xb1\x8b\xde\xad\x62\x0e\x4d\xab\x8a\xc3\x21\x8c\xaf\x3f\x5e\x1f\x3c\x12\x41\x62\x32\xc1\xde\xc8\x1c\xf8\x33\xb2\x5c\x12\x77\xe2\x4b\xab\x0e\x24\x31\xa1\x0c\x48\x10\xf0\x94\x29\xad\x1e\x59\x52\x74\xbc\xd2\x50\xe5\xad\xf2\xf1\x36\xe7\xe4\x48\x10\xa0\x94\x19\x8a\x31\x7a\xa6\xdb\x4b\xe6\x9a\x13\x50\x26\x69\x88\x05\x0d\xd2\x53\x13\x37\x10\xc3\x51\x2c\x69\x1c\xfb\x98\xcf\xb9\xd4\x95\x4f\x10\x96\x82\x6b\x1c\x4b\x59\x60\x4e\x60\x86\xa8\xf5\x43\x02\x67\x40\x20\xe6\xe6\xd8\xa7\x5d\x6b\x26\x62\x2a\x07\x16\xc3\xe8\x26\xe8\xc9\x8f\xf1\xe5\xa0\xcb\x75\x14\x7d\x42\x65\x49\xa9\x06\xeb\x18\xe0\x13\x95\xca\x04\x8a\xba\x3f\x54\x3a\x8b\xa5\x6c\xda\x87\x84\x27\x06\x69\x6c\x15\x33\xde\x5e\xfc\x7a\x71\x5b\x87\xd3\x9b\xbb\xec\xec\x8c\xd4\x7e\x7e\x88\x0e\x04\x2e\x50\x28\x0c\xf7\xdb\x02\x91\x4e\x9e\x37\xe7\x9e\x5d\x81\x8e\x93\x52\x15\xd3\x3b\x7d\x4d\xd3\xd3\x4d\xf3\x80\xd6\x9b\xc2\xa8\xc7\x44\xaa\xb5\xa6\x4d\xd1\x1e\x56\x2b\x0e\x8c\x4c\x63\x55\x47\xaa\xad\x9b\x2a\x35\xff\x62\xe6\x2f\x95\x2d\xe4\x34\x04\xfd\x6b\xad\x51\x45\x9b\x23\x60\xe9\x0b\x1e\xda\xbc\xcf\xcf\x1e\xd8\xfe\x9a\xf6\xbb\x3c\x0d\x0d\xae\xfd\x73\x68\x57\x86\xcf\x25\x7b\x59\x8e\xcf\x06\x99\x3c\xde\x2c\x1e\xef\x22\x96\x40\x55\x07\xcd\xc7\x0d\x79\x66\x6f\x04\xaa\x01\xfe\x33\x25\xb1\x3c\x38\xf6\xe2\xfe\x7c\x10\x5c\x94\x61\xa7\xea\x4c\x5d\x74\xf1\x52\x74\xd1\xb0\x39\x56\x4e\x11\xb4\x5c\xec\x71\x93\x73\x1e\x62\x2b\x43\x0f\xc7\x42\x8a\x87\x61\xec\x4c\x73\x93\x94\xa5\x22\x3d\xec\xe7\xb8\x3e\x22\x34\x4e\x05\xee\x9f\xf8\x9c\xb9\x4c\x45\x44\x02\xa3\x40\x12\xc1\x9c\x6f\x94\x20\xf9\x1c\x67\x7c\xd9\x51\x49\x66\xdb\x2f\x66\xfb\xbc\xf1\x54\x54\xb7\x84\x5c\xe9\x2b\x90\xc5\x1c\x9a\x26\x12\x52\x49\xa6\x58\xb0\x04\xaf\xa6\x6c\x97\x19\xb6\xa3\xd9\x1c\x42\x21\x69\x6e\xdb\x2c\xb9\xad\x94\x1f\xb6\x32\x00\x68\x50\xe1\x5a\x08\x95\x11\x99\x40\xaa\x70\x93\xf5\xcc\x86\x2e\x4d\x56\xf2\x02\xbd\x86\xbf\x4a\xb7\xc1\xab\xdf\x3b\xad\xde\xc3\x0b\x5c\x5f\x1b\x91\x1d\xf2\x4e\x9a\x2b\x64\x6d\xf1\xce\x76\x4a\xff\xa2\x34\xc8\xca\x66\xdc\x6b\x64\x1e\x3b\x97\x5d\xcb\x93\xd3\x0e\x82\x7d\xc5\x40\xad\x9d\x84\x09\x6b\xf4\x5d\x22\x70\x41\x79\xaa\x31\x1f\xfe\xe7\xaf\x39\x56\x62\xd7\x82\x0c\x8a\xfb\x93\x6b\xed\x7e\x5e\x9f\xdf\x37\x66\x52\x3c\xc0\xbf\x9c\xb9\xef\x6d\xd8\x78\xab\x80\x84\xb8\x41\xb3\xee\x58\x7f\x64\xbf\x84\xa1\xb9\x18\x16\xa3\xce\x33\xfc\xce\x99\x2b\x9e\x68\xa0\xee\x20\x57\x2c\x90\x84\xab\x1c\x7d\xf6\x6d\xe8\x00\x33\xc2\x42\xb7\x5e\x45\xc2\xd0\xec\x41\x19\xeb\xd7\x6d\x25\x53\x42\x59\x3d\xbc\xfb\x76\xc1\xd4\x06\x5f\x0b\x68\x8d\xc1\x8b\x68\xd3\x2d\x81\xd2\x18\x43\x33\x02\x7b\x45\xbd\xdc\x28\x4f\xa7\xe4\x19\x9b\x3e\x72\x50\xf1\x6a\xa5\xa3\x65\xc5\xa6\x9d\x73\x26\xd3\xb9\x89\xf3\x81\x2c\x08\x8d\xcd\x96\xa1\x89\xc6\x58\x08\x41\x8c\x84\xd9\x2f\xc1\x60\xa4\xf8\x02\x85\x2c\x8d\xfb\x66\x2e\xe5\x45\xee\xa4\x32\xf9\x66\xb7\x9e\x81\x6e\xf1\xbe\xad\x5e\xb7\xcd\xdb\xfa\xbc\x6c\x71\xf8\x3e\xc5\x44\x29\x67\x2e\x05\xa1\x5a\x37\x44\x95\x74\x6b\x74\x25\x21\x6f\xe6\x7c\x4c\x60\xa4\x29\x3f\xd4\x93\xe5\xfe\x3d\x9d\x12\x74\x99\xc9\x55\x1e\x88\xb9\xa1\x54\x9c\xf7\x21\x46\x62\x56\xa4\xb2\x0f\x0a\x95\xe3\xe3\x8e\x85\xbc\x82\x9f\xb3\x61\x5c\xcd\xd1\x99\x5d\xac\x19\x66\xfb\x11\x76\x81\x62\x82\xc8\x80\x2a\x14\x44\x61\x08\x5a\xef\xdd\xf7\x70\x74\xeb\x65\xc6\xd1\x08\x9d\x6a\xbf\xe4\x78\xbb\x8f\xd3\x68\x47\x42\xd9\xd4\xfa\x46\xfb\xaa\xe8\x1c\x03\xf5\x54\x75\x8e\x16\xed\x19\x1e\xd5\xa5\xf5\x24\x18\x79\x32\xd2\xab\x69\x5c\x36\x6d\xab\x4e\xa7\x9f\x97\x29\xed\x32\x7d\x9d\x52\x3f\xaf\xf0\x2c\x2c\xdb\x07\xea\x69\xa0\xef\x2b\xab\xd0\xeb\x15\x7a\x4d\x50\x5b\xa5\x2f\x6f\x86\x6a\x12\xf3\xa4\x64\xed\xe5\x12\x53\x22\x47\x55\x27\xa1\x9e\xea\x3e\xa2\x56\x4a\xbb\x87\x86\x92\xfa\x55\x5b\x69\xb7\x8f\xe0\xca\xd6\x47\xc6\x93\x2b\x56\xce\x0d\x6b\x3c\xf9\xe0\xc9\x16\xab\x7c\xd7\xa7\xf3\xb0\x41\xb9\x74\x79\xc5\x3f\xfb\x28\x50\x76\xc5\xee\x79\xd3\x41\x8a\x32\xb5\x45\xff\x45\xf9\xd9\x27\x3d\x6f\x57\xaf\x33\xea\xf6\x53\x1e\xe5\xb2\x66\xfe\xf1\x95\x31\x2f\x3c\xb4\x37\xe7\x8d\xd4\x37\xe7\x15\xd5\x33\x69\x25\x46\xed\x68\x31\x7b\xe5\xf9\xa4\x0d\x19\x1c\x67\xfe\xad\x63\x32\xd7\xa6\x98\x7b\xc2\x06\x1e\x3e\x2f\xe6\xaf\x72\x23\xfc\x60\xaa\xcc\xa6\xf5\x06\x1e\x55\x67\xba\x2e\x63\x66\xf9\xc6\xa1\x2b\x34\xb5\x10\x95\xa9\xa7\x1d\x5a\x96\x97\x6a\xea\x7e\x89\xbe\x95\xb3\x9b\x5c\x5d\x81\xea\xb9\xa2\x35\x57\xeb\x76\xdd\x07\x99\xb4\xbf\xa5\xff\x87\xae\x9a\xcc\xcd\xef\x15\xf0\xac\xa3\x00\x81\x36\x2f\xc7\xac\x1a\x98\xcf\x71\x4c\x4c\xac\x90\x4a\xca\xa6\x05\xe7\x1d\xa2\xa4\x02\x43\x88\x28\xc6\x21\xf0\x10\x85\x59\x06\xfe\x2a\xdd\xee\xe7\x70\x08\x12\x05\xd5\x4c\xed\xb7\xcb\xec\x67\x04\xcd\x17\xd5\x18\x0d\x50\xad\x20\x42\x62\xbe\x4e\xa5\x38\x24\x44\x4a\x98\x23\x61\x94\x4d\xa3\x34\x8e\x57\xc0\x45\x88\x9a\x7f\x71\x1d\x51\xcf\x1e\x1c\x52\x89\x42\xc2\x72\xc6\x1d\xe8\x35\x51\x6e\xa2\xc3\x7e\xaa\xfa\x6e\xc3\x86\xca\x24\x26\x2b\xa0\xca\x41\x6d\xd7\xbb\xd2\x84\x42\xca\x79\x6f\xe6\xdc\x0b\xd7\x68\xda\x3b\x9b\x04\x36\x7b\xdb\x37\x81\x04\x59\xca\xb6\x6f\xce\xc8\x57\x30\x7d\xd3\x84\x2d\x29\xf8\xbc\x3e\x41\xb8\xe8\xcd\x3b\x35\xac\x77\xf5\x3c\xf3\x40\x06\xd7\x1a\x9c\x7d\x11\x19\x7a\x3d\x7a\x90\xa7\x94\xb7\x79\xef\xa0\x9a\x4c\xde\xee\xb0\x3d\xf1\xac\xdf\x0b\x07\xeb\xe3\xbe\x6d\x4e\xdc\x4a\xa3\xf0\xc8\xef\xa5\x0b\x0b\x36\xad\xfe\x39\xa8\x1d\x4c\xf6\xba\xe4\x75\x78\xd0\xe0\x85\x8b\xf1\x83\xd7\xf1\x1a\xa1\xd2\x6a\xde\xa0\x71\x3e\xee\xad\xf9\xdf\xf7\xd8\xf3\xfa\x9b\x35\xb8\xd2\xa8\xce\xea\xaa\x0f\xe4\xda\x37\xf7\x8f\xb8\x7a\xe8\x46\xb4\xce\x9d\x14\xca\x34\x7f\xd5\xa7\x5e\xc9\x26\xf3\x82\xf7\x83\x5f\x85\xd2\x9d\x9f\xfe\x2a\xd0\xde\xd3\x7c\x83\x38\xf7\x69\x95\xf7\x2d\x5f\x25\xca\xfe\x39\xd7\x68\x0b\x66\xbe\xf0\x79\xef\xff\x03\x00\x00\xff\xff\x3c\x84\xc5\x95\x15\x55\x00\x00")
+var _call_tracer_finalJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xdd\x5d\x6d\x73\xdb\xb6\xb2\xfe\xae\x5f\x81\xf8\x43\x2d\x4d\x64\xc5\x69\xcf\xed\x99\x91\xeb\xde\xf1\x51\x9c\xd6\x1d\x37\xf6\xd8\x4e\x3b\x1d\x4f\x3e\xd0\x14\x24\x33\xa6\x48\x1e\x92\xb2\xa3\xdb\xe3\xff\x7e\x76\x17\x2f\x04\x40\xf0\x45\xb6\xd3\x7b\xef\xc9\x4c\xc6\x12\x09\x2c\x80\xc5\xee\xb3\x8b\xc5\x02\x7a\xf3\x86\xcd\xd2\x6c\x93\x47\xcb\xdb\x92\x7d\xbb\xff\xf6\xef\xec\xea\x96\xb3\x65\xba\xc7\xcb\x5b\x9e\xf3\xf5\x8a\x1d\xad\xcb\xdb\x34\x2f\x06\x6f\xde\xc0\xab\xa8\x60\x8b\x28\xe6\x0c\xfe\x66\x41\x5e\xb2\x74\xc1\x4a\xa7\x7c\x1c\xdd\xe4\x41\xbe\x99\x40\x05\x51\xc7\xfb\x1a\x29\x2c\x72\xce\x59\x91\x2e\xca\x87\x20\xe7\x53\xb6\x49\xd7\x2c\x0c\x12\x96\xf3\x79\x54\x94\x79\x74\xb3\x2e\xa1\xa1\x92\x05\xc9\xfc\x4d\x9a\xb3\x55\x3a\x8f\x16\x1b\x24\x09\xcf\xd6\xc9\x9c\xe7\xd4\x74\xc9\xf3\x55\xa1\xfa\xf1\xd3\x87\x8f\xec\x94\x17\x05\xbc\xfb\x89\x27\x3c\x0f\x62\x76\xbe\xbe\x89\xa3\x90\x9d\x46\x21\x4f\x0a\xce\x02\xe8\x38\x3e\x29\x6e\xf9\x9c\xdd\x10\x39\xac\xf8\x1e\xbb\x72\x29\xbb\xc2\xde\xa7\x40\x3f\x28\xa3\x34\x19\x33\x1e\x61\xcf\xd9\x3d\xcf\x0b\xf8\xce\xbe\x53\x4d\x49\x82\x63\x96\xe6\x48\x64\x18\x94\x38\x80\x9c\xa5\x19\xd6\x1b\x41\xaf\x37\x2c\x0e\xca\xaa\x6a\x0f\x86\x54\xe3\x9e\xb3\x28\xa1\x66\x6e\xd3\x0c\xc6\x78\x0b\xd4\x61\xd4\x0f\x51\x1c\xb3\x1b\xce\xd6\x05\x5f\xac\xe3\x31\x52\x83\xc2\xec\xf7\x93\xab\x9f\xcf\x3e\x5e\xb1\xa3\x0f\x7f\xb0\xdf\x8f\x2e\x2e\x8e\x3e\x5c\xfd\x71\x00\x85\x61\xde\xe0\x2d\xbf\xe7\x82\x54\xb4\xca\xe2\x08\x28\xc3\x10\xf3\x20\x29\x37\x30\x12\xa4\xf0\xeb\xf1\xc5\xec\x67\xa8\x72\xf4\x8f\x93\xd3\x93\xab\x3f\x60\x3c\xec\xfd\xc9\xd5\x87\xe3\xcb\x4b\xf6\xfe\xec\x82\x1d\xb1\xf3\xa3\x8b\xab\x93\xd9\xc7\xd3\xa3\x0b\x76\xfe\xf1\xe2\xfc\xec\xf2\x78\xc2\x2e\x39\xf6\x8a\x63\xfd\x6e\x9e\x2f\x68\xf6\x80\xaf\x73\x5e\x06\x51\x5c\x28\x4e\xfc\x01\x13\x5e\x40\x1f\xe3\x39\xbb\x0d\xee\x39\x4c\x7c\xc8\xa3\x7b\xe8\x61\xc0\x42\x90\xc9\xde\x93\x8a\xb4\x82\x38\x4d\x96\x34\xe6\x46\x81\x64\x27\x0b\x96\xa4\xe5\x98\x15\xd0\xf9\x1f\x6e\xcb\x32\x9b\xbe\x79\xf3\xf0\xf0\x30\x59\x26\xeb\x49\x9a\x2f\xdf\xc4\x82\x5c\xf1\xe6\xc7\xc9\x00\x69\x86\x41\x1c\x5f\xe5\x41\x08\x0d\xc3\xe4\x04\x0c\x78\x0e\xec\x8f\xd3\x07\xe0\x27\x70\xb0\x08\x42\x9c\x6a\xfc\x1c\x92\x30\xc2\x24\xf1\x2f\xf8\xad\x2c\x50\x68\x61\x3c\x59\x9a\xe3\xe7\x38\x56\x72\x16\x25\x20\x11\x09\x8c\x00\x69\x17\x6c\x15\xcc\x39\x48\x21\xd0\x36\x08\x8e\xcd\xc1\xa0\x18\x89\xe9\x86\xba\xc0\xc8\x15\x89\xe5\x64\xf0\xe7\x80\xc1\x3f\xd9\xc9\xa2\x0c\xc2\x3b\xec\x23\x36\x11\xae\xf3\x9c\x27\x25\x72\x73\x0d\x82\x07\x7c\xc5\x22\x4c\x94\x91\x2c\x3d\xfe\xed\x57\xe8\x2a\x14\x10\xc4\x90\x94\xa6\x33\x65\xd7\x9f\xc6\x03\x7a\xb6\x02\x1e\xa6\xf3\x77\x3c\x2b\x6f\xa7\xf8\x10\x9f\x7d\x5e\xaf\xb2\x39\x2f\xca\x5f\xe9\x5d\xed\xf1\x49\x12\x95\xef\xd7\x09\x0d\x44\x50\x72\xdf\x9e\x48\x16\x4c\xd9\x22\x88\x41\x81\x6a\x05\xa6\xc0\x8c\xb5\x7c\x1e\xa7\xd0\xaf\xdf\x82\x3c\x0a\x6e\x62\x5e\xe8\xd6\xa0\xa3\x25\xd7\x8f\xb1\x52\x04\x0f\xe6\x55\x83\x76\x01\xa3\x5e\x9a\x07\x4b\x7e\x14\x86\x28\x4f\x46\xf9\x2c\xe7\xf7\xe7\xb3\x29\xdb\x97\x23\x07\xc6\xa6\x59\x98\x42\x97\x40\x14\xd7\x49\x29\x58\xab\x1e\x09\xd6\x21\x80\xf0\x05\xca\x35\xbe\x5b\x44\x79\x51\x0a\x56\x2f\xf2\x60\x45\x10\x79\x97\x80\xac\x08\xf6\xca\xaa\x56\x0b\xf0\x1d\x04\x6e\x0e\x74\x50\x68\xee\x0a\xf6\x70\x4b\x42\xcb\x1e\xf8\x2e\x4c\xdb\xe7\x35\x10\xac\xca\x2c\xf2\x74\x05\xe2\xc0\x40\xa7\x51\xda\x0c\x01\x04\xa1\x4a\x15\xcd\x00\xbf\x82\x92\x50\x4f\x44\xd3\x9a\xc4\x54\x32\xbc\x6a\x1f\xfb\x74\x1e\x60\x77\x81\x66\x21\x1f\x88\xb1\x66\xd5\x63\x10\x1a\x14\x7e\x31\xa9\xa2\x1f\x84\xbc\x69\x26\xe5\x49\x11\x24\xf9\x19\xe3\x30\x24\x53\xe2\x00\x86\x90\x26\xc2\x5e\xac\x09\x73\xf1\xd1\x84\x1d\x03\x28\x6e\xaa\x36\x58\x19\xdc\x41\xbb\x01\x6a\x44\xb2\xb1\xc8\xb1\x22\x4e\x4b\x7a\x15\xe1\x2c\x6c\x00\x0e\x13\xce\xe7\xc5\x18\x04\x7c\x01\xed\x24\x21\xa7\xa7\x50\x24\x47\x08\x09\x24\xa7\x56\x1c\x20\x67\x83\xe8\x6c\x28\x0a\x80\x7a\x30\x21\x04\xce\x83\x07\x49\xff\x21\xcd\xe7\xaa\x72\xb9\xce\x13\xc4\x1f\x98\x0a\x1e\xc7\x63\x04\x61\xa9\x37\x39\xd8\x1b\xc5\x4e\x9b\x6b\xd3\x8a\x35\xc3\x38\x5d\x8e\x0d\xce\x8d\x98\x50\x53\xfc\x77\x1f\xe4\x0c\x3a\x9b\xe2\x54\x1e\xb2\x9d\x9d\x03\xeb\xcd\x7d\x10\xaf\x61\x08\x87\x20\x90\xf6\x0b\x6a\xfe\x3c\x2d\x22\x6a\xe0\x90\xed\x57\xaf\x11\x56\x87\x58\x26\x82\xe7\x55\xa3\x93\x98\x27\x4b\x40\x8e\x3d\xf6\xf6\x00\x5e\xfd\x88\x75\x58\xb4\xb7\x67\x76\x46\x51\x47\xc6\x9d\xcc\x01\x2d\xa2\x45\x04\xb3\x60\x92\xb9\x8e\x3e\x4d\xf0\xf5\x07\xf8\x4e\x1f\xde\x81\x18\xe5\x11\x59\xb7\x89\x5d\xef\xa0\x46\xb7\x88\xfe\x87\x03\x35\x60\xc7\x64\xc9\xcb\x4b\x1c\xc3\x25\x3c\x1a\xda\xf5\x46\x76\x45\x3d\x9e\xcf\x34\x4e\xf8\xf3\x03\x11\x82\x4f\xaf\x5f\xbb\xbd\xc7\x7f\x8a\x9d\xaf\x0f\x19\xda\xcd\x64\x79\x95\xfe\xcc\xbf\xe0\x24\x4c\x88\x6d\x93\x8c\xf3\xbb\xa1\xcd\xc1\xd7\xec\xf3\x68\x52\xa6\x97\x54\x7e\x38\x72\xba\xf0\xe8\x8c\x04\x27\x65\xb2\x4e\x8a\xdb\x68\x51\x0e\xeb\x1d\x48\x80\x37\x53\x87\x67\xf8\x6c\x5c\x2b\x89\x03\x9f\x6e\xc3\x5d\xd1\xc1\x3a\x21\xea\xd3\x94\xfd\x72\x79\xf6\x61\x02\xe4\x0a\x4e\xc3\x15\x32\xe9\xb0\x77\x6c\x0b\xcf\x68\x64\x53\x7b\x74\xc6\xee\xf0\xe9\x50\xf0\x7e\x50\xb1\x46\x7f\x14\x7a\xc2\xfe\x94\xfc\x9f\xaa\x89\x18\x4b\x8e\x4d\xe5\xdf\x47\x51\xfb\xb1\x42\x9c\x75\x06\x1a\x08\x63\x33\xc1\x59\xa3\x4e\xb0\x0c\xa4\xd3\x43\xe8\x8d\x82\x24\x4b\x48\xab\x25\x2d\x9b\x56\xe8\x34\x21\x63\xcb\xee\xc0\x40\x31\x30\xbe\x54\x35\x46\x0b\x0f\x96\x37\x0e\x42\x60\x20\x3e\x8b\x72\x82\xf7\x28\x5d\x17\xf1\x46\xb6\x36\x57\x93\x4b\xc4\x7c\xdd\xaa\xa9\x35\x52\x76\x15\xba\xea\xe2\x21\xb4\x14\x15\x13\xdb\xee\x5c\x97\x5a\x20\x55\x67\x51\x1f\x8e\xe6\xf3\x1c\xec\x0f\x48\x9f\xa3\xec\xa2\x1f\x88\x0f\xce\xfc\xa2\x12\x41\xf3\x9a\xf0\x90\x3a\x33\x62\xff\xfa\x97\x05\x18\x16\x22\xa0\xd6\x83\x06\x49\x9a\x12\x15\xe0\x59\x5d\x97\x7c\x8a\xa7\x47\xa6\x2b\x36\x28\x61\xb4\xa0\xba\x72\xc0\x9f\x85\x02\xb0\xc3\x43\xd5\xb0\xd2\x09\xf6\xcd\x37\xcc\x2a\xa7\xa7\xcf\x2e\xab\x1e\xfb\xda\x92\x7c\xd2\x34\x98\x59\xf3\xc0\x5b\xfc\x06\x2c\xc2\x5d\xfd\xd5\x63\x83\xda\x3f\xba\x12\x5b\x94\x3c\x43\xeb\x15\x25\xf7\xe9\x1d\x5a\x61\xe0\x15\x27\xe3\x25\x2c\xba\x70\xfb\x50\xf2\xb4\x4b\xa5\xa4\x0a\xab\x4e\x05\x21\x47\x94\xe6\x37\xe6\xe8\xa0\x95\x59\x90\x81\x4a\x71\xf2\xf6\x78\x9e\xc3\x72\x0b\xbc\xf5\x15\xac\x83\x60\x6c\xf1\xc6\xb6\x21\xf8\xba\xc2\xd6\x63\xfc\x3a\x34\x54\x19\xe7\x43\x94\x79\x85\x8c\x05\xbb\xbf\x88\xc0\xa6\xb9\xec\x24\x69\x5d\x04\xeb\xb8\xd4\x5d\xb2\xb9\x24\x94\xdc\xab\xff\xd8\x61\x74\x8a\x0c\x9f\x08\x9c\x01\x58\x56\xcc\x0b\xe9\xe4\x82\x03\xb2\x4a\x2d\x77\x68\x62\x75\x90\x5a\xd7\x1e\xa7\xb2\x58\x3f\xb2\x7d\xaf\x91\x02\x3f\x43\xaa\x97\xae\x72\xed\xa7\x00\x36\xcf\x91\x03\xa8\x3c\x51\x6e\xdb\x21\x34\x6c\x7c\x05\xe5\x81\xf6\x5e\x83\x99\xac\x06\xc9\x38\x78\x48\x3e\x56\xc9\x4a\xaf\x5f\x7b\x39\xa2\xc7\x64\x7b\xc7\xdd\xfa\x8f\xc2\x9f\xc0\xb2\xc2\x3b\x3d\xdb\x13\x03\x34\x68\x98\xb0\x4b\x07\x52\x03\x5a\x8e\x09\x28\x24\x7f\x49\xba\xc6\x2c\x4d\x0c\x77\x56\x48\x7f\xc2\x02\x93\x94\x6e\x9d\x1d\x2d\xd0\x77\x0b\x74\xdd\x80\xfc\x6a\x74\x00\xa3\xf0\x96\xd6\x4c\x71\x91\xa2\x3b\x98\x80\xcf\xf6\x00\xbe\x5d\x96\x01\x26\x9b\xb4\xee\xf8\xa6\xc0\x35\xe0\x03\x28\x32\x2c\xa5\x13\x00\x68\x6c\xbd\xea\xa7\x89\xed\x81\x74\xdb\x09\x8a\x71\x04\xb6\x50\xbd\xf2\x20\xb0\x5e\x1a\x74\x73\xcf\x3b\x05\xdb\xe2\xb9\x1f\xba\x05\x7b\x2a\xf4\xae\x23\x77\x43\x83\x5b\x74\x1f\x55\x04\x96\x4d\x5e\x92\xd6\xa2\xa7\x17\x29\x8d\x1b\x35\xdd\xd0\xa2\xbe\x3d\xd9\x6e\x40\xf2\x19\x62\x81\x4f\x4f\x6b\x72\xf4\x15\xd9\x61\xc9\x1e\x56\x07\x68\xa9\x1c\x4b\x54\xec\x9d\xcb\xd3\xb3\xa3\x77\x3b\x38\xd7\x0d\xef\x2f\xaf\xce\x2e\x8e\x77\x1a\x24\x6f\xfb\x6e\x96\xe9\xef\xb0\x50\x19\xee\xee\x7f\xd9\x05\x5c\x73\xfc\xe0\x7d\xc3\xef\x7d\xfb\xbd\xe9\xf9\xda\x48\xb1\x4c\x19\x06\x8e\x30\x1e\xa8\x42\x09\xda\x54\x63\x54\x43\x04\x05\xc6\x18\x4a\xc1\xc8\x86\x74\xce\x56\x03\x8f\x37\x41\xee\x1c\x8c\xc5\x5e\xc5\x6f\xaf\x8a\x9a\xe4\xdd\x93\x49\x82\xb7\xd0\xe4\xbd\x3c\x95\xde\xf5\xdd\xa7\x49\xa6\xfc\xe5\x1f\xb5\x45\x3e\xcb\x66\x80\xaa\xb4\xe6\x99\xdd\x06\xc9\x92\xbf\x48\x03\x23\xf4\x9f\x9e\x43\x28\x8e\x16\xbc\x8c\x56\xbc\xc9\xaf\x7a\x0e\x6d\xf2\xa5\xeb\xd0\xa7\x56\x25\xcf\xa0\xec\xae\x68\x5e\x84\x95\xa3\x17\x67\x80\x16\x83\xbd\xaf\x2d\x06\x75\x4f\xd6\xe7\xb2\xbc\xc4\x98\x94\xc0\xc0\xbc\x52\xdc\x68\x5b\x27\x5a\xc7\x02\x36\x05\xb9\x81\x87\x06\x4a\x7e\x58\xaf\x6e\x38\xb8\xad\xec\x1b\xb6\xff\x65\xb1\x4f\x68\x88\x1f\xec\xe5\x10\xf9\x7d\x35\xe4\xb4\xcb\x64\x61\xe5\x0b\x9f\xcf\xf0\xad\x89\x65\x17\x20\x81\xf9\x5c\xae\x29\x4d\x1f\xa5\x5a\x54\x56\xce\x2a\xf9\xa9\xe3\x2a\x86\x4c\x81\x27\x93\x1a\x30\x28\x8d\x31\x3e\x5d\xa6\xde\x65\x2a\x3c\xdb\xb0\xdb\x34\x9e\xdb\x7e\xc9\x10\xc7\x61\x59\x03\xf5\x40\xc1\xbf\x52\xed\xbe\x1e\x31\xf9\x3f\x4f\x86\x7b\x45\x45\x70\x02\xe8\xfc\xd9\x10\xae\x48\xb3\x7a\xfc\x01\x9b\x9e\x32\x21\x43\xb4\x08\xad\x17\xe1\x5f\x32\x14\x25\x0a\x00\x1b\x8b\xd7\x63\xfd\x58\x54\x44\x46\x68\xbb\xea\x84\x26\xec\xce\x22\x13\x5d\x96\xd5\xfb\x2c\x86\xa3\xb1\x48\x74\xb1\x95\x45\x6f\x1d\x16\xb9\xc1\xa0\x26\xcd\xf2\xb6\x44\x80\x77\x23\xc3\x5d\x25\x6f\x56\x30\x19\x49\xa8\x45\x9e\x6a\xf3\x23\xc2\xc8\x4f\x5e\xf9\x20\xd7\xc4\xd2\x4b\x89\xfe\x61\x9b\xff\x45\x76\xd6\x2e\x5e\xf3\x50\x6d\x85\xb7\x4a\x4f\x30\xbc\x3b\x14\x9c\x19\xf9\xbc\x24\x5b\x1b\x81\x3b\x05\xe3\xab\xa8\xa4\x28\xfa\xc6\xaf\x89\x6a\x7d\x42\x81\xa1\xa2\xd4\x91\x66\x49\x0e\x37\xb6\x4a\xad\xc7\x44\x0c\x23\x3e\x58\x67\x60\x8b\xce\x24\x82\x51\x7f\x39\x5b\x0c\x77\x4e\xcf\x7e\xda\x11\x68\xb3\xb5\xd2\x41\x97\xcf\x16\x0b\x89\x36\xb6\xa2\x91\x24\x00\x79\x8f\x92\x41\xe1\xe3\x64\x2e\x6a\x61\x75\x9f\x10\xb6\x54\xc7\x80\xb5\x6c\x52\x04\xb4\x27\x05\xee\x52\x0d\x05\xb5\xb1\x24\xef\xa9\x08\x0b\xdd\x28\x2c\x3c\x73\xa8\x7d\xa9\x52\x04\x7b\x4a\xf6\x03\xab\xe3\xf2\x1e\xc0\x71\x80\x6f\xfd\x41\x1f\x41\x5d\xcc\x79\x0f\x45\xfb\x16\x1e\x95\xae\xb2\x75\x2b\x80\x92\x0f\x1f\x42\x05\x42\x9d\xa6\xac\xcb\x9c\x8d\x1b\x3a\x3f\x95\x7f\xeb\xef\x91\xe7\x8a\x2e\x7e\x1e\xb5\x82\x13\xcd\x92\x10\xd4\xd3\x74\xd9\xe4\x04\xc1\xab\x21\xbd\x10\x81\xea\x68\xb1\x19\x8a\xe6\x01\x0e\xa8\x8d\x51\x5d\x79\x0d\xaa\xaf\x1a\xc2\x04\x04\xb7\x82\x4b\x32\xee\x66\xf4\x85\x9e\x1c\x34\x96\x2f\xa2\x65\x12\x50\xd4\xc9\xaa\xa4\x1f\x37\xd7\x94\xa5\x4f\x92\x6c\x5d\x16\x76\xed\x88\x9e\x35\x57\x0d\x61\x05\x11\xe1\x1a\xcf\xa9\x57\x3d\xef\x29\x15\xf9\xf3\x80\x51\x12\x99\x10\x0e\x75\xe2\xa2\x5d\xba\x03\x16\xcd\xc2\x42\x43\xe4\xe0\xbd\xb0\x48\x86\xb8\x28\x7f\x29\x68\x77\x47\x9a\xcb\xa3\xa2\x1c\x66\xa1\x13\xd4\x53\xa5\x5e\x81\x19\x44\x59\xd8\xf1\x81\x14\xee\xb7\x59\x22\x28\x6b\x8d\x1a\xc3\x56\x4f\x8b\x5b\x5d\x67\x21\xae\xe7\x49\x28\x11\x49\xa9\x5d\x25\xa3\x60\xd8\x2d\xa2\xe6\x9e\x70\xbf\x90\x18\x0c\xde\x19\x9c\x18\xd8\x93\xfb\x7a\x50\x97\xa3\x74\x5d\x82\xac\x3a\x7b\x71\x8e\x46\x9f\xa9\x32\xd7\x1e\x19\xc2\x8d\x4c\x11\x25\xad\x36\x03\x27\xc6\xb6\xe9\xab\x66\x9d\x35\x9a\x50\xa3\x72\xb7\x15\x45\xd8\xa3\xa3\x8d\x51\x5d\xd3\xf4\xb0\x24\xfd\x89\xdc\x9c\xa9\x97\x74\xc7\xa8\x2a\x88\x9d\x91\x36\x11\x6f\x0b\xe1\xbe\xf5\x8d\x57\x2e\x00\x9c\x3a\x59\x9a\x0d\x3d\x23\xa0\x9d\x6b\x3d\x0c\xf1\xa1\xa1\x54\xc3\x10\xce\xda\xea\xd0\x72\xa8\xb0\xb5\xc4\x85\xe6\x27\x2d\x9b\x88\x3a\x46\xfa\x3f\x8d\x9a\x46\x65\x47\xf7\xba\xfb\xb0\x6d\xf8\xd1\xd7\x70\x2d\x36\xfd\x12\xea\x68\x2d\x30\x8d\x19\x45\xb8\xc3\xaf\x5b\xae\x53\xc9\x53\xe2\x8b\xb2\x2e\x23\x72\xf7\xc9\x5b\xab\x2e\x86\xd7\x44\x04\x21\x5f\x3c\xeb\x86\x76\xff\x40\xea\x74\x6a\x08\xe0\xd7\x0c\xb3\x6b\x34\xe3\xcb\xa0\x68\x8f\x7b\xd6\x64\x04\x6a\x7c\x2c\x08\x17\xa4\x33\x75\x13\x2d\x4f\x92\xb2\x22\xb7\xa7\xcb\xcd\xd0\x59\xde\x53\x56\xe3\xa7\x00\x27\xcc\xf2\xb2\xb6\xe9\xf2\x9c\xc7\x80\x29\x9a\xf6\x49\x72\xd0\xa7\x18\x76\xe1\xe0\x2b\xb0\xa0\x71\xf8\xfd\x06\x38\xd8\x7e\x8e\x0d\xe1\x3d\x68\xae\x6e\xa4\x44\x75\xeb\xce\xde\x9e\x2f\x68\xd2\x6f\x91\xe9\x74\x76\xff\x53\x37\x24\xd6\xab\x6c\x89\x8f\x75\x02\x5f\x0d\x2c\xd1\xb0\xa1\x7f\xea\x85\xac\x7a\x3f\xfe\x6a\xe0\x7c\xf4\x6d\x7b\xa0\x0f\xd2\x60\xd4\x8d\x10\xc5\x2f\x1f\x7f\x3d\x7f\x77\x7c\x79\xb5\x83\x7e\x51\x16\xa2\x49\xdc\xc7\x05\x25\xe5\x3f\x2c\xe3\x0d\x9b\x0e\xbd\x18\x4d\x1c\x81\xd9\xb9\xc2\x14\x27\x24\xa4\x30\xfa\x1d\x6a\x0d\x45\xff\x76\x9a\x34\x47\xd5\xcf\xb6\x75\x44\x9e\xe4\x90\x64\x3d\x5d\x11\x93\x38\x2d\x0c\xfa\x78\x25\x9e\x1e\x9d\x24\xdd\x0e\x4a\x87\xea\x7b\x8d\x60\x1b\x43\x9a\x5d\x1c\x0c\x1e\xb4\xd5\x54\x9d\x97\x3e\x4f\x7b\x41\xb1\x1e\x7d\xca\x2a\xd6\xfd\x47\x90\x3d\xb5\x6c\x41\xaf\x4a\x08\xe0\xba\x1a\x7e\xe9\x53\x4f\xc4\x07\x77\x66\x47\xa7\xa7\x3b\xed\xa5\x1f\x9b\x67\xb8\xd5\x23\xf0\x70\xb2\x9f\xf7\xd8\x6e\xe4\xb4\x89\xa1\xb8\x71\x16\x1e\xb4\x97\x42\x6f\x0e\xca\x29\xb0\xea\x28\xad\x64\x9c\xfe\x76\x94\xf5\x8b\xf6\x49\x7b\xcd\x67\xb9\x71\xa2\xb6\x48\x74\xb5\xb3\x1e\x9e\x6f\xe8\xec\xc4\xc4\xad\x26\xb9\xb7\xaa\x64\xe1\x14\xfe\xb7\x4b\x1b\xce\xd7\x54\x4f\x57\x7b\x59\x29\xc3\x1a\xae\x3b\x28\xe7\xe9\xea\xb9\x6a\xfa\x7c\x45\x27\xc9\x9a\x8a\x3f\xed\x25\x4d\x81\x9a\x5a\xdf\xda\xeb\x6d\x0d\x23\x4f\x81\x10\xb0\x1a\x3c\x29\x4f\xc9\xb7\x98\xbe\xb8\x6b\xd1\x3b\x2c\xa4\x16\x6b\xcd\x9d\x7d\x7c\x49\x25\x31\x73\x87\xfa\x7a\xab\xa6\xb2\x37\x6f\xc4\x3d\x39\x63\xe8\xda\xc1\x84\x4f\x02\xec\x0e\xb6\x5b\xeb\x1d\x74\xd8\xdc\x6d\x67\x90\x1d\x76\x3b\x2c\x4f\x24\x6c\x26\x48\xf5\x5b\xc5\xfd\x25\x32\xf8\xd5\x46\xbc\x7d\x47\xfa\x73\xe8\xb1\x23\x42\xe4\x3a\xb4\xbb\xaa\xdf\xef\x78\x18\x83\xdf\x88\x86\x6b\xb7\x29\x3a\xa6\x76\x55\x1b\xcd\x82\xc8\xbd\x6e\x87\x7a\x01\xf1\x58\xe6\x49\xb9\xd6\x8a\x44\x95\x80\xd0\x83\x98\x91\xad\xe0\x25\xa8\x76\xf2\xe9\x24\x88\xdf\x20\x52\x86\x77\xc3\x5b\xb5\x2b\x6f\x1e\x92\x71\x10\x6b\xf0\xbf\x22\x39\x02\x0f\xd4\xb4\x8d\x3a\x53\x03\x60\x35\x74\x82\xe7\x4a\x30\x31\x50\xe7\x17\x45\x05\xbb\xe1\xb4\x89\x97\x73\x4c\x7b\x1b\xe3\x3e\x8f\xda\x6e\xaf\x8e\x31\x59\x01\x6c\x95\x5f\x00\xab\x2d\xb1\x02\xdb\x9d\x5d\x1c\x1f\x5d\x1d\xef\xfa\xe2\xe3\x51\xe2\xdd\xc3\x6b\xdf\x84\x8b\x12\xb1\x85\x27\x2a\xd7\x77\xb7\xac\xca\x56\x6d\x18\xe4\x51\x51\xf0\x15\x0a\x72\xed\xf8\x97\x3c\x1f\x46\x49\x55\xb8\x8b\x2a\x0e\xca\x85\xe9\x2a\x8b\x39\x4a\xc8\xa0\xb7\x9b\xd4\xbc\x5d\xff\x54\xb7\x45\x3a\x1a\x55\x45\x6b\xf7\x91\x18\x31\x16\x7c\xf1\xd5\xee\xe5\x44\xf4\x75\x1c\xa4\x3e\xf4\xca\x72\x68\xdb\xa8\x6b\x31\x5f\xb5\x62\xd5\xa9\x2b\x91\x55\xd9\x91\x19\xed\xca\x74\x5d\x9e\x0b\x1e\x2f\xf0\x20\x16\x10\x0b\x49\xae\x97\x01\x9d\xf4\xa2\xec\xf1\x00\x0f\x17\x16\xeb\x1b\x9a\xdf\x32\x4d\x3b\xc4\xfb\xf2\xf8\xf4\x3d\x7a\xac\x17\x1f\x67\x57\x5e\x21\xef\x1f\x49\x7d\x89\x08\xea\xd6\x91\xd3\xc7\xc1\xb6\x41\xb9\x3f\x95\x80\x3f\x7a\x93\xd4\x3b\xd0\x45\x38\x69\x94\xc1\x1f\x06\xe2\xc0\x9c\x9a\x96\x79\x9a\xf0\x2d\x31\x66\xa8\x40\x06\xd6\xbe\xbb\x55\x32\x0f\x7d\x9f\x9d\xbd\x3b\x36\x9f\xbd\x3b\x3e\x3d\xfe\x09\xa0\xc8\x2d\x7b\x79\x75\x74\x75\x32\xa3\xa7\x23\x97\xa5\x98\xab\x7d\x17\x65\x74\x14\x00\x1c\xb3\x3d\xc4\x03\x3a\x71\xad\xbb\x5f\x60\x1a\x5c\x8a\x67\x99\x73\x79\x48\x70\x11\x24\xa1\x3a\x90\x50\x78\x52\x01\x28\x49\x45\xa9\x7b\x47\x12\x4c\x5d\x40\xa2\xe2\x3c\xe7\xb2\x1b\xf3\x61\x99\x8e\x7c\x52\xe0\xcc\x84\xef\x30\x55\xce\x52\xc2\xde\x61\x7f\xee\xb0\xff\x66\xfb\x6c\xca\xde\x8e\x3c\xfb\x77\x0d\x50\x8e\xb9\x06\xd0\xce\x73\x00\xfd\xbb\x1e\x24\xd4\x69\x42\x5f\x72\x86\x05\x8f\xff\x29\x16\xa1\x5a\xbc\x82\x04\x74\x18\x0c\xc5\x1c\x4f\x39\xf5\x6a\xaa\x3f\x7d\x65\xf3\x91\xae\x4b\x98\x8d\xa9\x3b\xc7\x7f\xab\xcd\xb1\xb7\xea\x29\x4f\x6a\x55\xff\xab\x47\xd5\xff\x63\x2b\xdc\x1e\xd9\x75\xaf\x6a\x1a\x29\x2c\xce\x2b\x07\xaf\x1a\x76\x77\x75\x36\x5c\x43\x06\x50\xdb\xb6\xcd\xa3\xab\x21\xef\xb8\x3c\x5a\x25\x01\xd9\x48\x00\x63\x47\xff\x38\xd1\xe7\x01\xe1\x1d\xe7\x74\xae\x9f\xd2\x31\xd3\x75\x1e\x72\xbc\xd5\xc0\x21\x57\x95\xd5\x87\xb2\xc7\x74\x9a\x58\x1f\xcb\x32\x0f\x3e\x8b\x14\x16\xd1\xa6\x4b\x29\xc5\xd3\xc6\x11\xd9\x75\x8e\x8a\xcb\x8d\x54\x4f\x27\x68\x3d\x13\xaa\xe9\xcd\x06\xc2\x77\x14\x8b\x01\x5d\x1a\x6b\x45\x68\xc9\x01\x22\x62\x6d\x49\x40\x66\xb4\xd2\xa8\xd3\x10\xb5\x14\x7b\xd1\xf5\xfc\x9f\x99\xf5\xbc\xa1\x9e\x2f\x85\x67\x66\xbf\x68\xdf\xad\x77\x62\x9e\x33\x1d\x3a\x2d\xfa\xfb\x09\xcf\x70\xdf\x1a\x02\x37\x80\x6a\x3a\x48\xba\x55\x58\xe7\x60\xd0\x15\xb5\xa9\x9f\xcb\xe9\x72\x21\xbd\x17\x00\xe0\x31\x7f\xfb\x7c\x3f\x1e\x74\x05\x9d\xe2\xf7\x28\x94\xbb\x05\x35\x84\x67\x32\xd2\x07\x70\x08\x40\x31\x7e\xb7\xf2\x25\xf1\xd8\x3c\x3a\x03\xf2\x8e\x0a\x94\x2f\x3a\xf9\x85\x09\xcf\xf2\xe0\xad\xd0\x36\xd2\x03\x90\x8b\x55\xb0\xc1\xdb\x46\x40\xac\xee\x36\x88\xb7\x6c\xbe\x01\x79\x8a\x42\x2b\x29\x9a\xd2\xa5\x73\xbe\x0c\x72\x22\x9e\xf3\x7f\xae\x61\xe4\x98\xb5\x09\x46\x0c\x9a\x59\x03\x49\xa8\x1d\xe1\x2d\x24\x48\x63\xf8\xed\x77\xfb\xfb\x60\xdd\xa2\x0c\x46\x35\x66\xdf\x7f\xf7\xe6\xfb\xbf\xb1\x7c\x0d\x4b\x46\xcf\x11\x41\x3d\x78\xdf\x7e\x9b\x04\x7e\x9a\xa6\xe1\x08\x4f\x60\x34\x01\xe1\xf6\x93\xda\xc3\xd1\x7d\xb9\xc6\x04\x54\x8b\x4d\x6f\xd3\xe8\xf5\x4c\x47\xc6\x3b\x65\xce\xde\x9d\x0d\xef\x82\x3c\x88\x83\x1b\x3e\x9a\xd2\x0d\x07\x34\x97\x0f\x81\xbc\x64\x04\x45\x87\x65\x31\x9e\xb0\x0e\x42\xba\xd2\x02\xc5\x43\x9d\xf0\xc1\x73\xd1\x69\xb2\x5b\xfa\x68\xd3\xd5\x2c\x32\x57\x5c\x7a\xc9\x24\x67\xd8\xdf\x60\x45\xe7\x40\x01\x9c\x23\x0b\xaf\xd1\x7f\x49\xc9\x85\x95\x25\xf0\xe6\x1a\x1f\x71\x4c\xf7\x8d\x49\xca\x1e\x72\xbc\xf3\xa4\x88\x24\xc2\xce\x39\xca\x47\x81\xc7\x11\x03\x86\xe9\xee\x80\xf9\x62\x7f\x25\xc8\x97\xc5\x44\xf8\xc8\xd8\x05\xf4\x90\x00\xd1\x27\x5d\xd0\x61\x62\x82\x13\x46\xad\x2d\x1b\x12\xd0\x91\xa8\x10\xd9\xc4\x38\x1e\x80\x7b\xa1\xb1\x18\x26\x62\x19\xdd\x7b\xb1\xd8\x2e\x26\x71\x71\xfc\xdb\xf1\x45\x7d\xb9\xd6\xdf\x92\xab\x73\xbf\x3b\xfa\xde\x16\xe8\xd3\x3d\xcf\x41\xd5\x76\x0e\x9e\x43\xf3\x7c\x26\x77\xc2\x5a\xac\x70\xce\x03\x4c\x3d\x14\x7e\x0b\xde\x70\x91\x44\x21\x25\x5a\xe3\x6d\x41\x21\xc0\x14\xc0\x88\xe8\xa0\x62\x8a\x4b\x4c\xf4\xb5\x10\x70\xa1\xaf\x10\xd1\x37\x6e\x08\xff\xb9\x66\x4b\xa1\xd6\x13\x72\xae\xa1\x96\xf0\xef\x65\xf5\x2d\x73\xae\x45\x57\x9b\x6c\xf7\x05\xbd\xad\xc7\x44\x44\x5b\x63\xd9\xf8\xc8\x67\xcc\x25\xe1\x36\x3b\xde\x7f\xee\x74\x2f\xc5\x87\x36\xd3\xd9\x72\x94\xdb\x83\xa3\x87\x2f\x89\xa3\xd8\x57\xcf\x0a\xf7\xdc\x50\x21\xba\x23\x46\xc3\x06\x54\x17\xe9\xfc\x86\x94\x17\xeb\xb8\x2c\x06\x5b\xed\x0a\x0f\xbc\x99\x48\xa5\x8a\x44\x37\x44\x08\x2b\x08\x28\x4d\x00\x0d\x98\x28\x6f\x98\x5b\x7a\xaf\x4f\x45\x8a\xf1\x52\xff\x65\x92\x8e\x75\x08\x61\xab\xf4\xae\x93\xe4\x79\x09\x5e\x3d\xd2\xb8\xbc\x29\x5c\xde\x28\x3c\x8c\xd7\xa7\x7c\xfe\x24\xc3\x57\x50\x7a\x02\xe6\x1f\xc0\x15\xca\x34\x6d\x65\x88\x89\x48\x8d\x13\x3a\x4a\x5c\xb0\x7a\xdb\x79\x1c\x7f\x7e\xa8\xcc\xd3\xbf\x11\x6b\x40\x3c\x6e\xd8\x42\xd0\x43\xd1\xc8\xef\x21\xc2\x12\x67\xfb\xe4\xab\x99\xe5\xd9\x8e\x5e\xc9\x2f\x82\x28\x06\xf7\x79\xe7\xc0\x67\x99\x8b\x75\xbe\x08\x42\x12\x20\xbc\x19\x0d\x2f\x60\x28\xc0\x56\xae\xf8\x6d\xfa\xd0\xd1\x88\x02\xea\x67\x93\x7d\xec\xed\x57\xd4\x35\x41\x0b\xbd\xe3\x7f\xd2\xa5\x6b\x50\x62\x5d\xd0\x21\x3b\xad\x09\x83\xe7\xa7\x05\x3e\x51\x6d\x5e\x33\x23\x63\x72\xdb\x14\xc9\xad\x84\x7f\x3b\x05\x30\xc8\x0b\xdb\xe7\x89\x20\x29\x11\x27\x8b\x62\x7c\x51\x83\x12\x91\x89\x51\x47\x8e\x89\xa3\x23\xaa\xc1\x96\x6a\x7a\x72\x9c\xc4\x41\x73\x9a\xd0\xa9\x91\x0b\x41\x19\x02\xeb\x9d\xdc\xe9\x2c\x91\x2f\xc4\x3d\x42\x2d\x8b\x64\xd1\xbe\xa1\xd2\x12\x3d\x46\x63\xbb\x0b\x63\xcd\xcd\x51\xcb\xe8\x9c\x45\xb5\x6c\xfe\x55\x8f\xbd\xd7\x3e\x49\xe7\x17\x8e\x95\xdd\x2e\x29\xe9\xb1\x2d\x7b\xe6\x29\xe0\xf4\x97\x01\x94\x1f\xa4\x9e\xb4\x87\xfc\x1c\xfb\xd5\x56\x48\x28\x4f\x67\x19\x50\xa9\xf6\x32\x4a\xdc\xba\xce\x46\xf4\xc7\xb7\x67\xa5\x3b\x3b\x89\x23\x2f\x71\xc2\x40\xea\x57\x2d\x1f\x16\x6d\x41\xf2\x99\x87\x65\x65\x0f\x28\x1c\x41\x37\x05\xca\xcb\xb5\xf0\xa2\xbf\xff\xfc\xbd\x28\x27\xe6\x64\xcc\x81\x99\x4b\x53\xe9\x80\x71\x97\x14\x29\x93\x79\x99\x14\xdd\x8c\x23\x6e\xb6\xc1\x38\x89\xe1\xf4\xa6\xb4\x0a\x95\x77\x2b\x2d\xc4\xa5\xa9\x14\xbd\x47\x12\xd3\xce\xfb\xa4\xa4\xdd\x2e\xd3\xac\xba\x86\x29\x88\xf1\x72\xc4\x8d\x5e\x35\x8e\xc5\x92\x1f\xd6\xf7\xc9\x5c\x6e\x46\x80\x23\x4b\xb9\x09\x84\x11\xd8\x57\x3b\x08\xea\x99\xa1\x97\x0e\x82\xf4\xb8\xb9\xaa\x35\x76\x66\x2e\x2c\xe4\xd6\x18\xee\x5a\xd9\x4b\xd2\xde\x39\xa5\x16\x7e\x36\x5d\xb8\xe5\x60\x5f\xe3\x5d\x04\xb3\x34\x29\xd6\x2b\x8a\xcf\xb1\xe0\x1e\xba\x45\xe9\x2d\x14\x45\x01\x6f\x29\x8c\x39\x4c\x38\x5d\x1a\x0c\x52\x97\xe2\x9d\xc1\x83\xed\x21\xe5\x59\x70\xe2\xf8\x59\xea\xab\x87\xd1\x2d\x18\xdd\x8a\xcd\x6d\x98\xdc\x86\xc5\x7e\x0c\x36\x99\xfb\x3e\x0e\xca\x52\x2a\x93\x31\xe5\x02\xa4\x30\xd4\x2f\x36\x64\x06\xdb\x43\x13\xad\x90\xb1\xa4\xe7\x58\xf8\xff\x4f\xc8\xea\x54\xa2\x53\xbd\x22\x97\xac\x2c\xd3\x74\x0c\xec\x0a\xee\xc5\xe6\x87\xbe\xa4\xd1\x88\x7a\x75\x84\xe7\x0d\x14\x14\xeb\xf9\x1a\x0c\x52\xee\x83\x71\xc7\x2e\x85\x1d\x6f\x38\xbc\x89\xc0\x6d\xa1\x6b\x11\x51\x2b\xe4\xc5\xca\xd8\xfb\x62\x60\xec\xef\x00\x7f\x83\x58\xd1\x96\xfb\x43\x08\x33\x20\xe9\x02\x39\xc5\x2b\x13\x3a\xc3\xf2\x8b\x0b\x9d\xc2\x2f\x27\x1a\xee\xae\x2a\xa6\x1f\xd7\xcf\xa5\xb8\x09\xc9\x22\x01\xb9\x5e\x0e\x9f\xdb\x25\xf5\x36\x8b\xaf\xb8\x7e\x39\x76\x34\x54\xed\xb0\xf8\x2a\x55\x6f\xed\x5a\x62\x2f\xb8\x5e\x1e\x9f\x3b\xbd\x37\xf6\x86\x81\x3b\x13\xfc\xee\x6c\x6e\x56\xdb\xc0\x58\xa0\xb6\x15\x6c\x27\xeb\x60\x11\x7a\x62\xa1\x8e\x5d\x03\x40\x62\xea\x82\x15\x54\xab\x61\x55\xad\xd6\x47\xba\xc0\xd9\x5b\x13\x5f\xb5\xd5\xd6\x97\x31\x0f\x3d\xa7\xac\x9c\xdb\x01\xcd\xdb\xff\x6c\x2a\x72\xcb\x5b\xf6\xa0\x4e\xc9\x93\xa5\x6d\x67\x65\x37\x9e\xd7\xf2\xe4\x69\x3b\x17\x5a\x77\x1e\x91\xb2\x6b\xdb\xdb\xd1\xea\xd2\x6b\x9d\x53\x28\x9f\x37\x1d\xff\x72\x98\x47\xeb\x1e\x53\x0a\xc4\x93\x91\x77\xa8\x67\xaa\x74\xfb\xd9\x34\x77\xb0\x74\xa7\x88\x7f\x94\xf4\xca\x1d\xc0\xb2\xa1\xfb\x4b\xa7\xf3\x64\xa7\x7d\x45\xe9\x85\xa7\x2c\x5e\x0a\xde\x50\xfa\x7c\x36\x76\x30\x15\x1d\x2b\x5f\x71\xf1\xc6\x51\x24\x4a\xe2\x24\x25\x8a\xcc\xfc\xd5\xc7\x83\x36\x7f\x6b\x5f\xd9\x85\x0e\x17\x09\x21\x4c\x5b\x90\x06\x1a\x4d\x77\xd9\x35\x0c\xb7\x57\x93\xca\x59\x6a\xa0\x71\xd0\x58\x87\x7c\xa7\x46\x46\x7b\x6f\x21\x44\xde\x6d\xdf\x33\x5d\xab\x69\xf8\x56\xf9\x56\xca\xd2\x2d\x91\x15\xdc\x53\x99\x8f\xee\x7d\xc9\xe2\xca\x55\xb4\x53\x78\x15\xb6\xa8\xa5\xcc\xe3\xc0\x58\x25\xc8\x12\x78\x81\x3f\x65\xc1\x52\xd8\x8d\x6e\xda\xbb\xa1\x15\xd8\xba\x90\x17\x1b\x4b\xa3\x07\xc0\x14\xe5\x78\x8d\x67\xc4\x63\x30\x92\xf8\xc3\x18\xb8\x29\xf6\xb9\x90\x09\x43\x78\x8b\x2d\x07\x58\x00\xa2\xe2\xc7\x03\xc4\xef\x78\xd0\x4f\x1a\x24\x51\xc8\xcb\x0d\x5b\x70\x91\x09\x00\xce\x52\x16\x14\x05\x5b\x81\x33\x0a\x8d\xe0\x0f\x1e\x6c\xc4\xdd\xe7\x7c\x6e\x05\xe2\xd1\xea\xa6\xf8\xc3\x04\x39\xde\x5a\x9f\xca\xa5\x04\x45\x18\x32\x8c\x9b\x45\xe5\x58\x6e\x5f\x47\x45\x16\x07\x1b\x78\x20\x17\x30\x72\x74\x96\x21\x0e\xec\xe8\x0b\x5d\x71\x95\xe6\xbe\xab\x5f\xd0\x0a\xcb\xf3\x5b\x3e\xc3\xab\xd3\x20\x1a\x6d\xad\x9d\xfa\xd0\x6c\x5e\x9d\x54\x07\x9f\x45\xd5\x5b\x0a\x3e\x23\x2a\x7a\x02\x1f\xeb\xe6\x53\xae\xb1\xbd\x86\xb3\x4a\xa5\xf1\x58\x49\xe5\x54\x37\x98\x42\xd3\x7f\x6f\xb0\x77\xc2\xbb\x6e\x31\x66\xd5\x39\xb6\x36\xc3\x55\xcb\xe6\x68\xb7\x55\x9e\xc0\x84\xdf\x00\x19\xf7\x33\xb4\xd9\x2f\x21\x00\xc6\x23\xbf\x81\x32\x62\xa0\xad\xa6\xa9\x1e\xd4\x6b\xb0\x46\x72\x1c\x8d\x06\x48\x76\xbf\xc1\xe6\x54\xeb\xc4\x06\x33\x63\x2e\x24\xfd\x96\x85\x4a\xb4\x18\x13\x12\xac\x68\x55\x13\xeb\x38\xae\x24\x3a\x36\xd9\xf5\x58\xbf\xb5\xf3\x8e\x6f\xe8\x67\x0f\x48\xff\x7c\x0b\x1e\xf1\xe6\x1a\xca\x7d\xea\x5e\xdd\x48\x88\x34\xea\x1c\x74\x9e\x0c\xa8\x1a\xe9\x63\xeb\x7c\x77\x9e\x9b\xb5\xdb\x2e\x3e\xa7\xd9\x35\xca\x5e\x47\x3a\x05\x48\xe3\xb4\xf3\x7e\xd4\xdd\x7f\x09\xf7\xa2\xa2\xc2\xf7\xc7\xc1\xbf\x01\xfb\xca\x58\x4c\x6a\x69\x00\x00")
 
 func call_tracer_finalJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -89,7 +89,7 @@ func call_tracer_finalJs() (*asset, error) {
 	return a, nil
 }
 
-var _call_tracer_originalJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x59\xdf\x6f\x1b\x37\xf2\x7f\x96\xfe\x8a\x49\x1e\x6a\x09\x51\x24\x27\xe9\xb7\x5f\xc0\xae\x7a\xd0\x39\x4a\x6a\xc0\x8d\x03\x5b\x69\x10\x04\x79\xa0\x76\x67\x25\xd6\x5c\x72\x4b\x72\x2d\xef\xa5\xfe\xdf\x0f\x33\xe4\xae\x56\x3f\xec\xe8\x7a\xb8\x43\xef\x45\xd0\x2e\x67\x86\xc3\x99\xcf\xfc\xe2\x8e\x46\x70\x66\x8a\xca\xca\xc5\xd2\xc3\xcb\xe3\x17\xff\x0f\xb3\x25\xc2\xc2\x3c\x47\xbf\x44\x8b\x65\x0e\x93\xd2\x2f\x8d\x75\xdd\xd1\x08\x66\x4b\xe9\x20\x93\x0a\x41\x3a\x28\x84\xf5\x60\x32\xf0\x5b\xf4\x4a\xce\xad\xb0\xd5\xb0\x3b\x1a\x05\x9e\xbd\xcb\x24\x21\xb3\x88\xe0\x4c\xe6\x57\xc2\xe2\x09\x54\xa6\x84\x44\x68\xb0\x98\x4a\xe7\xad\x9c\x97\x1e\x41\x7a\x10\x3a\x1d\x19\x0b\xb9\x49\x65\x56\x91\x48\xe9\xa1\xd4\x29\x5a\xde\xda\xa3\xcd\x5d\xad\xc7\xdb\x77\x1f\xe0\x02\x9d\x43\x0b\x6f\x51\xa3\x15\x0a\xde\x97\x73\x25\x13\xb8\x90\x09\x6a\x87\x20\x1c\x14\xf4\xc6\x2d\x31\x85\x39\x8b\x23\xc6\x37\xa4\xca\x75\x54\x05\xde\x98\x52\xa7\xc2\x4b\xa3\x07\x80\x92\x34\x87\x5b\xb4\x4e\x1a\x0d\xaf\xea\xad\xa2\xc0\x01\x18\x4b\x42\x7a\xc2\xd3\x01\x2c\x98\x82\xf8\xfa\x20\x74\x05\x4a\xf8\x35\xeb\x01\x06\x59\x9f\x3b\x05\xa9\x79\x9b\xa5\x29\x10\xfc\x52\x78\x3a\xf5\x4a\x2a\x05\x73\x84\xd2\x61\x56\xaa\x01\x49\x9b\x97\x1e\x3e\x9e\xcf\x7e\xbe\xfc\x30\x83\xc9\xbb\x4f\xf0\x71\x72\x75\x35\x79\x37\xfb\x74\x0a\x2b\xe9\x97\xa6\xf4\x80\xb7\x18\x44\xc9\xbc\x50\x12\x53\x58\x09\x6b\x85\xf6\x15\x98\x8c\x24\xfc\x32\xbd\x3a\xfb\x79\xf2\x6e\x36\xf9\xfb\xf9\xc5\xf9\xec\x13\x18\x0b\x6f\xce\x67\xef\xa6\xd7\xd7\xf0\xe6\xf2\x0a\x26\xf0\x7e\x72\x35\x3b\x3f\xfb\x70\x31\xb9\x82\xf7\x1f\xae\xde\x5f\x5e\x4f\x87\x70\x8d\xa4\x15\x12\xff\xb7\x6d\x9e\xb1\xf7\x2c\x42\x8a\x5e\x48\xe5\x6a\x4b\x7c\x32\x25\xb8\xa5\x29\x55\x0a\x4b\x71\x8b\x60\x31\x41\x79\x8b\x29\x08\x48\x4c\x51\x1d\xec\x54\x92\x25\x94\xd1\x0b\x3e\xf3\x83\x80\x84\xf3\x0c\xb4\xf1\x03\x70\x88\xf0\xe3\xd2\xfb\xe2\x64\x34\x5a\xad\x56\xc3\x85\x2e\x87\xc6\x2e\x46\x2a\x88\x73\xa3\x9f\x86\x5d\x92\x99\x08\xa5\x66\x56\x24\x68\xc9\x39\x02\xb2\x92\xcc\xaf\xcc\x4a\x83\xb7\x42\x3b\x91\x90\xab\xe9\x7f\xc2\x60\x14\x1e\xf0\x8e\x9e\xbc\x23\xd0\x82\xc5\xc2\x58\xfa\xaf\x54\x8d\x33\xa9\x3d\x5a\x2d\x14\xcb\x76\x90\x8b\x14\x61\x5e\x81\x68\x0b\x1c\xb4\x0f\x43\x30\x0a\xee\x06\xa9\x33\x63\x73\x86\xe5\xb0\xfb\xb5\xdb\x89\x1a\x3a\x2f\x92\x1b\x52\x90\xe4\x27\xa5\xb5\xa8\x3d\x99\xb2\xb4\x4e\xde\x22\x93\x40\xa0\x89\xf6\x9c\xfe\xfa\x0b\xe0\x1d\x26\x65\x90\xd4\x69\x84\x9c\xc0\xe7\xaf\xf7\x5f\x06\x5d\x16\x9d\xa2\x4b\x50\xa7\x98\xf2\xf9\x6e\x1c\xac\x96\x6c\x51\x58\xe1\xd1\x2d\xc2\x6f\xa5\xf3\x2d\x9a\xcc\x9a\x1c\x84\x06\x53\x12\xe2\xdb\xd6\x91\xda\x1b\x16\x28\xe8\xbf\x46\xcb\x1a\x0d\xbb\x9d\x86\xf9\x04\x32\xa1\x1c\xc6\x7d\x9d\xc7\x82\x4e\x23\xf5\xad\xb9\x21\xc9\xc6\x12\x84\x6d\x05\xa6\x48\x4c\x1a\x83\x81\xce\xd1\x1c\x03\xdd\xb0\xdb\x21\xbe\x13\xc8\x4a\xcd\xdb\xf6\x94\x59\x0c\x20\x9d\xf7\xe1\x6b\xb7\x43\x62\xcf\x44\xe1\x4b\x8b\x6c\x4f\xb4\xd6\x58\x07\x32\xcf\x31\x95\xc2\xa3\xaa\xba\x9d\xce\xad\xb0\x61\x01\xc6\xa0\xcc\x62\xb8\x40\x3f\xa5\xc7\x5e\xff\xb4\xdb\xe9\xc8\x0c\x7a\x61\xf5\xc9\x78\xcc\xd9\x27\x93\x1a\xd3\x20\xbe\xe3\x97\xd2\x0d\x33\x51\x2a\xdf\xec\x4b\x4c\x1d\x8b\xbe\xb4\x9a\xfe\xde\x07\x2d\x3e\x22\x18\xad\x2a\x48\x28\xcb\x88\x39\x85\xa7\xab\x9c\xc7\x3c\x1e\xce\x0d\x20\x13\x8e\x4c\x28\x33\x58\x21\x14\x16\x9f\x27\x4b\x24\xdf\xe9\x04\xa3\x96\xae\x72\xec\xd4\x31\xd0\x6e\x43\x53\x0c\xbd\x79\x57\xe6\x73\xb4\xbd\x3e\x7c\x07\xc7\x77\xd9\x71\x1f\xc6\x63\xfe\x53\xeb\x1e\x79\xa2\xbe\x24\xc5\x14\xf1\xa0\xcc\x7f\xed\xad\xd4\x8b\x70\xd6\xa8\xeb\x79\x06\x02\x34\xae\x20\x31\x9a\x41\x4d\x5e\x99\xa3\xd4\x0b\x48\x2c\x0a\x8f\xe9\x00\x44\x9a\x82\x37\x01\x79\x0d\xce\x36\xb7\x84\xef\xbe\xe3\xbd\xc6\x70\x74\x76\x35\x9d\xcc\xa6\x47\x2d\x25\xa4\xbe\xcc\xb2\xa8\x07\xf3\x0e\x0b\xc4\x9b\xde\x8b\xfe\xf0\x56\xa8\x12\x2f\xb3\xa0\x51\xa4\x9d\xea\x14\xc6\x91\xe7\xd9\x36\xcf\xcb\x0d\x1e\x62\x1a\x8d\x60\xe2\x1c\xe6\x73\x85\xbb\xb1\x17\x83\x93\xe3\xd4\x79\x4a\x4e\x04\xb4\xc4\xe4\x85\x42\x02\x50\xbd\x6b\xb4\x34\x6b\xdc\xf1\x55\x81\x27\x00\x00\xa6\x18\xf0\x0b\x82\x3d\xbf\xf0\xe6\x67\xbc\x63\x77\xd4\xd6\x22\x00\x4d\xd2\xd4\xa2\x73\xbd\x7e\x3f\x90\x4b\x5d\x94\xfe\x64\x83\x3c\xc7\xdc\xd8\x6a\xe8\x28\xf7\xf4\xf8\x68\x83\x70\xd2\x9a\x67\x21\xdc\xb9\x26\x9e\x08\xca\xb7\xc2\xf5\xd6\x4b\x67\xc6\xf9\x93\x7a\x89\x1e\xea\x35\xb6\x05\xb1\x1d\x1d\xdf\x1d\xed\x5a\xeb\xb8\xbf\x76\xfa\x8b\x1f\xfa\xc4\x72\x7f\xda\x40\xb9\xc9\x08\xc3\xa2\x74\xcb\x1e\x23\x67\xbd\xba\x8e\xfa\x31\x78\x5b\xe2\x5e\xa4\x33\x7a\x76\x91\xe3\x50\x65\x94\x36\xbc\x2d\x13\x46\xd0\x42\x70\x52\xe1\xa0\x16\x94\x64\x5d\x39\x67\x9b\x7b\x63\x1e\x04\xd2\xf5\xf4\xe2\xcd\xeb\xe9\xf5\xec\xea\xc3\xd9\xac\x0d\x27\x85\x99\x27\xa5\x36\xcf\xa0\x50\x2f\xfc\x92\xf5\x27\x71\x9b\xab\x9f\x89\xe7\xf9\x8b\x2f\xe1\x0d\x8c\xf7\x44\x77\xe7\x71\x0e\xf8\xfc\x85\x65\xdf\xef\x9a\x6f\x93\x34\x18\xf3\x6b\x00\x91\x29\xee\xdb\x39\x62\x4f\xd8\xe5\xe8\x97\x26\xe5\x3c\x98\x88\x90\x4a\x6b\x2b\xa6\x46\xe3\xc1\xc1\xd7\xab\xa3\x6f\x72\x71\x71\x04\x7f\xfc\x01\xad\xe7\xb3\xcb\xd7\xd3\xf6\xbb\xd7\xd3\x8b\xe9\xdb\xc9\x6c\xba\x4d\x7b\x3d\x9b\xcc\xce\xcf\xf8\x6d\x3f\x5a\x65\x34\x82\xeb\x1b\x59\x70\x42\xe5\x34\x65\xf2\x82\x3b\xc3\x46\x5f\x37\x00\xbf\x34\xd4\x73\xd9\x58\x2f\x32\xa1\x93\x3a\x8f\xbb\xda\x69\xde\x90\xcb\x4c\x1d\x2b\xbb\xa9\xa0\x0d\xd4\x7e\xe3\x46\xe9\xde\x5b\x8c\x9b\xa6\x3d\x6f\x6a\xbd\xd6\x06\x0d\x1e\xe1\x5c\xc7\x49\xa6\x77\xf8\x21\xe1\x6f\x70\x0c\x27\xf0\x22\x66\x92\x47\x52\xd5\x4b\x78\x46\xe2\xff\x44\xc2\x7a\xb5\x87\xf3\xaf\x99\xb6\xbc\x61\xe2\x9a\xdc\x9b\xff\x7e\x3a\x33\xa5\xbf\xcc\xb2\x13\xd8\x36\xe2\xf7\x3b\x46\x6c\xe8\x2f\x50\xef\xd2\xff\xdf\x0e\xfd\x3a\xf5\x11\xaa\x4c\x01\x4f\x76\x20\x12\x12\xcf\x93\xad\x38\x88\xc6\xe5\x6e\x86\xa5\xc1\xf8\x81\x64\xfb\x72\x13\xc3\x0f\x65\x8b\x7f\x2b\xd9\xee\xed\xca\xa8\xf7\xda\xec\xbb\x06\x60\xd1\x5b\x89\xb7\x34\x59\x1d\x39\x16\x49\xfd\xa9\x59\x09\x9d\xe0\x10\x3e\x62\x90\xa8\x11\x39\xb9\xc4\x7e\x96\xda\x11\x6e\xf1\xa8\x27\x8d\x93\x09\x43\x4c\x70\xdb\x69\x11\x72\x51\xd1\x64\x92\x95\xfa\xa6\x82\x85\x70\x90\x56\x5a\xe4\x32\x71\x41\x1e\xf7\xb2\x16\x17\xc2\xb2\x58\x8b\xbf\x97\xe8\x68\xcc\x21\x20\x8b\xc4\x97\x42\xa9\x0a\x16\x92\x66\x15\xe2\xee\xbd\x7c\x75\x7c\x0c\xce\xcb\x02\x75\x3a\x80\x1f\x5e\x8d\x7e\xf8\x1e\x6c\xa9\xb0\x3f\xec\xb6\xd2\x78\x73\xd4\xe8\x0d\x5a\x88\xe8\x79\x8d\x85\x5f\xf6\xfa\xf0\xd3\x03\xf5\xe0\x81\xe4\xbe\x97\x16\x9e\xc3\x8b\x2f\x43\xd2\x6b\xbc\x81\xdb\xe0\x49\x40\xe5\x30\x4a\xa3\xf9\xee\xf2\xf5\x65\xef\x46\x58\xa1\xc4\x1c\xfb\x27\x3c\xef\xb1\xad\x56\x22\x36\xfc\xe4\x14\x28\x94\x90\x1a\x44\x92\x98\x52\x7b\x32\x7c\xdd\xbb\xab\x8a\xf2\xfb\x91\xaf\xe5\xf1\x68\x24\x92\x04\x9d\xab\xd3\x3d\x7b\x8d\xd4\x11\x39\x71\x83\xd4\x4e\xa6\xd8\xf2\x0a\x65\x07\xc3\xa9\x39\x52\xd0\xe4\x58\x0b\xcc\x8d\xa3\x4d\xe6\x08\x2b\x4b\x73\x86\x93\x3a\xe1\x41\x3b\x45\xb2\xb6\x03\xa3\x41\x80\x32\x3c\xdd\x73\x8c\x83\xb0\x0b\x37\x0c\xf9\x9e\xb6\xa5\x9c\xa3\xcd\x6a\xb8\x09\xe4\x36\x54\xb9\xa3\xdf\x6a\x07\x34\xe0\x9d\x74\x9e\x1b\x48\xd2\x52\x3a\x08\x48\x96\x7a\x31\x80\xc2\x14\x9c\xa7\x0f\xec\x25\xaf\xa6\xbf\x4e\xaf\x9a\xe2\x7f\xb8\x13\xeb\x16\xff\x69\x33\x01\x81\xa5\xf1\xc2\x63\xfa\x74\x4f\xcf\xbe\x07\x50\xe3\x07\x00\x45\xf2\xd7\xb5\xf1\x7d\xeb\x38\x4a\x38\xbf\x76\xcc\x02\xc3\xf8\xd2\x56\xc0\x95\xca\xbb\xad\xdc\xbd\x9d\x1c\x4c\x51\x57\x08\x52\x8a\xd3\x0e\x25\xf6\x3d\x9d\x75\x34\xb8\x6f\x03\x4f\x40\xa0\x69\x25\x00\x5e\xaf\x3b\x34\x11\x72\x3e\x6b\x68\x4a\x4f\x4e\xa7\x2a\xbd\x4e\x71\x0b\xe1\x3e\x38\xf6\x6d\x4c\x72\x73\xb9\x38\xd7\xbe\x57\x2f\x9e\x6b\x78\x0e\xf5\x03\xa5\x6e\x78\xbe\x11\x2b\x7b\x72\x60\x27\x45\x85\x1e\x61\x2d\xe2\x14\xb6\x5e\x91\xa0\x70\x68\x36\x8d\x45\xbf\x5b\x82\x8f\xa3\x34\x32\xcb\x13\x8b\x7e\x88\xbf\x97\x42\xb9\xde\x71\xd3\x12\x84\x13\x78\xc3\x45\x6c\xdc\x94\xb1\xba\xce\x11\xcf\x46\x93\x11\x05\x06\xb6\x68\x8d\x9a\x2d\x9d\x87\xda\x94\xe2\xa3\x12\xa2\x88\x98\x1c\x1a\x8f\x45\xf8\xed\xeb\x32\x3b\x6d\x02\x78\xda\x94\xfd\x4c\x48\x55\x5a\x7c\x7a\x0a\x7b\x92\x8b\x2b\x6d\x26\x12\xf6\xa5\x43\xe0\x11\xd4\x81\x33\x39\x2e\xcd\x2a\x28\xb0\x2f\x45\xed\x82\xa3\xc1\xc1\x56\x91\xe0\xbb\x14\xe1\xa0\x74\x62\x81\x2d\x70\x34\x06\xaf\x1d\xb5\x77\x2e\xfe\xd3\xd0\x79\xd6\x3c\x7e\x03\x45\x61\x97\x6f\x42\xe3\x31\x6c\xec\xf5\xf2\x4e\x2f\x53\x13\x71\x47\xd3\x7a\xa8\x55\x0d\x0d\x47\x83\x9c\x7f\xc5\xef\xff\x19\xc7\x07\xcf\xc7\xdf\x43\x03\x6d\x9b\x36\x9c\x71\x93\x38\x9c\x74\xdd\xc4\x7c\x1b\x05\xcd\xea\x43\x00\x78\xa8\x3f\x22\xa8\xea\xdf\x30\xf1\x6b\xb8\x72\x4b\x43\x4f\x85\xc5\x5b\x69\x4a\xaa\x56\xf8\xbf\x34\xff\x35\xfd\xdd\x7d\xb7\x73\x1f\xef\xbc\xd8\x7d\xed\x4b\xaf\xd5\x32\xde\xd9\x86\xd6\xa8\x55\x2b\x0c\x17\xd2\x78\x15\x96\x85\xdb\xd4\x0e\xf3\x3f\x72\xf9\x15\xe3\xdd\x9b\x82\x6a\x7f\x2c\x45\xca\xa2\x48\xab\xa6\xfa\x0d\x42\xd7\x01\x4b\xa1\xd3\x38\x79\x88\x34\x95\x24\x8f\xb1\x48\x1a\x8a\x85\x90\xba\xbb\xd7\x8c\xdf\x2c\xb9\xfb\x90\xb1\xd3\xc8\xb6\xab\x66\x9c\x18\x69\xbc\x63\x8d\xbb\x07\x54\xc7\xad\x58\xda\xbe\xc7\x8b\x57\x81\x46\xbb\x32\xe7\xb6\x17\xc4\xad\x90\x4a\xd0\xa8\xc5\xed\x94\x4e\x21\x51\x28\x74\xb8\xbd\xc7\xcc\x9b\x5b\xb4\xae\x7b\x00\xc8\xff\x0c\xc6\xb7\x92\x63\xfd\x18\xcd\x71\x78\xcc\x1e\x1a\xb1\xe1\xf8\x6f\x94\xf0\x3e\xc2\xab\x65\xde\x10\x59\xd2\xf3\x87\x1d\xd4\xbe\x7b\x58\x48\x71\x83\x44\x34\x3f\xc1\x71\xab\x09\xff\xab\x04\xd9\x2e\xc4\x2e\x9a\x66\x2c\x1e\xde\x1b\x33\x00\x85\x82\x47\xa2\xfa\xb3\x4b\xdd\x7c\x3e\x36\xa1\xd5\xd1\x1b\xda\xb7\x9d\xf0\xe5\x4b\xac\x25\xd6\xd7\x1d\xa1\x8f\x9f\x23\x6a\x90\x1e\xad\xa0\xe1\x87\xd0\x15\xbf\x14\x90\x96\x8e\xc5\xb1\x5f\x24\x05\x5d\x14\x1c\xaf\xed\xa9\x3e\x4b\xbd\x18\x76\x3b\xe1\x7d\x2b\xde\x13\x7f\xb7\x8e\xf7\x50\x0c\x99\x33\x5e\x00\x34\xf3\x7f\xe2\xef\xb8\x67\xe4\x19\x79\xeb\x12\x80\xd6\xe8\x55\x18\xa0\xb7\x46\x7e\x66\x8c\x63\xff\xf6\xcd\x22\xad\xf1\xbb\x0d\x80\x33\xe9\x42\xb8\x20\x66\x2b\x24\xfc\xdd\x6e\x44\xd4\x0c\x14\x0c\x27\xfb\x19\x68\x69\x0f\xd3\xd6\x35\x04\x11\xf3\xab\xb0\x1a\x0a\xfb\x49\x7b\x35\xbc\x8a\x07\x95\x79\xcb\x36\x32\x67\xdb\xdc\x9f\xee\x4f\x72\xc7\x35\x1e\xf7\x27\x33\xb2\x79\x03\xd8\x07\x58\xdb\x83\xc5\x2e\xc9\x63\xa9\x92\xa5\xd7\x99\xed\x01\x56\x96\xde\x6a\x3d\xfc\xdd\xe1\x22\x1b\xe2\xb6\x8a\x1b\x34\xfb\x84\xc4\x3c\x13\xe9\x82\x65\x6b\x01\x01\xd5\x41\x57\x46\xb4\xfc\x07\x46\x89\xed\xf8\xa9\x97\xc0\x62\xf8\xb0\xc0\x0d\x29\x85\x8f\x99\x73\xf1\x2f\x1d\xcd\x8c\xeb\xb8\x48\xd1\x49\x8b\x29\x64\x12\x55\x0a\x26\x45\xcb\x13\xe9\x6f\xce\xe8\xf0\x09\x09\xad\x24\x89\xe1\x53\x59\xf8\x6a\xcd\x1f\xf0\xb4\x4c\xd0\x57\x90\xa1\xe0\x6f\x41\xde\x40\x21\x9c\x83\x1c\x05\xcd\xa0\x59\xa9\x54\x05\xc6\xa6\x48\xc2\x9b\xa1\x8c\x42\xd2\x40\xe9\xd0\x3a\x58\x2d\x4d\x2c\x93\xdc\xa5\x15\xd4\x74\x4a\x3f\x88\xf7\x2e\xd2\x15\x4a\x54\x20\x3d\x95\xe4\x78\xa8\x76\x94\x36\x1f\x60\xf8\x2b\x8e\xa1\xaa\xbb\x1b\xa2\xf5\x5c\xb7\x19\xa3\xfc\x9a\x9e\x36\xa3\x33\xce\x35\x9b\x71\xb9\xbe\x91\xda\x0c\xc2\xba\x6c\x6c\x46\x5a\xbb\x08\x6d\x86\x13\xaf\xf0\xd3\x66\x20\xb5\xfa\x65\x5e\x60\x70\x34\x0c\xfc\xb4\x15\x5a\xac\x65\x8c\xad\xf0\xb9\xb1\x21\xe7\xa7\x41\x04\x0c\x79\xb1\x47\xc6\xb9\xc1\x8a\x32\x71\xb0\x51\xab\xac\x84\x17\x9f\x6f\xb0\xfa\xb2\xbf\x8a\x44\x38\xb6\xe8\x9a\xb2\x51\x43\x3a\xac\x3d\x12\xc8\x8d\x16\x72\x7c\x7c\x0a\xf2\xc7\x36\x43\x5d\xf9\x40\x3e\x7b\x56\xef\xd9\x5e\xff\x2c\xbf\xd4\xd1\xd9\x20\x7e\x6b\xbd\xbf\xa1\x51\x8c\x91\x40\x43\x41\xd1\xbd\xef\xfe\x33\x00\x00\xff\xff\xb5\x25\x8b\x4d\x94\x21\x00\x00")
+var _call_tracer_originalJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd5\x59\xdf\x6f\xdb\x38\x12\x7e\x8e\xff\x0a\xb6\x0f\x8d\x8d\xba\x4e\xda\xee\xf5\x80\x64\xd3\x83\x2f\x75\xda\x00\xd9\x26\x48\x9c\x2d\x8a\xa2\x0f\xb4\x44\xdb\x6c\x64\x51\x27\x52\x71\x7d\xdd\xfc\xef\xf7\xcd\x90\x92\x65\x5b\x49\x7c\x3d\xdc\x61\x2f\x0f\xad\x25\xce\x0c\x87\x33\xdf\xfc\xa2\xf6\xf6\xc4\xb1\xc9\x16\xb9\x9e\x4c\x9d\x78\xb5\xff\xf2\xaf\x62\x38\x55\x62\x62\x5e\x28\x37\x55\xb9\x2a\x66\xa2\x5f\xb8\xa9\xc9\x6d\x6b\x6f\x0f\x4b\xda\x8a\xb1\x4e\x94\xc0\xff\x99\xcc\x9d\x30\x63\xe1\xd6\xe8\x13\x3d\xca\x65\xbe\xe8\x81\xc1\xf3\x34\x2e\x93\x84\x71\xae\x94\xb0\x66\xec\xe6\x32\x57\x07\x62\x61\x0a\x11\xc9\x54\xe4\x2a\xd6\xd6\xe5\x7a\x54\x38\x6c\xe4\x84\x4c\xe3\x3d\x93\x8b\x99\x89\xf5\x78\x41\x22\xf1\xae\x48\x63\x95\xf3\xd6\x4e\xe5\x33\x5b\xea\xf1\xfe\xe3\xb5\x38\x53\xd6\x62\xed\xbd\x4a\x55\x2e\x13\x71\x51\x8c\x12\x1d\x89\x33\x1d\xa9\xd4\x2a\x21\xa1\x38\xbd\xb1\x53\x15\x8b\x11\x8b\x23\xc6\x13\x52\xe5\x2a\xa8\x22\x4e\x0c\xe4\x4b\xa7\x4d\xda\x15\x4a\x93\xe6\xe2\x56\xe5\x16\xcf\xe2\x75\xb9\x55\x10\xd8\x15\x26\x27\x21\x6d\xe9\xe8\x00\xb9\x30\x19\xf1\x75\xa0\xf5\x42\x24\xd2\x2d\x59\xb7\x30\xc8\xf2\xdc\xb1\xd0\x29\x6f\x33\x35\x19\xce\x38\x85\x74\x9c\x7a\xae\x93\x44\x8c\x94\x28\xac\x1a\x17\x49\x97\xa4\x81\x58\x7c\x3a\x1d\x7e\x38\xbf\x1e\x8a\xfe\xc7\xcf\xe2\x53\xff\xf2\xb2\xff\x71\xf8\xf9\x10\xc4\xf0\x1b\x56\xd5\xad\xf2\xa2\xf4\x2c\x4b\x34\x24\xe3\x88\xb9\x4c\xdd\x02\x27\x21\x09\xbf\x0d\x2e\x8f\x3f\x80\xa5\xff\xf7\xd3\xb3\xd3\xe1\x67\x9c\x47\x9c\x9c\x0e\x3f\x0e\xae\xae\xc4\xc9\xf9\xa5\xe8\x8b\x8b\xfe\xe5\xf0\xf4\xf8\xfa\xac\x7f\x29\x2e\xae\x2f\x2f\xce\xaf\x06\x3d\x71\xa5\x48\x2b\x45\xfc\x8f\xdb\x7c\xcc\xde\x83\x5d\x63\xe5\xa4\x4e\x6c\x69\x89\xcf\x70\xb8\x85\x8e\x49\x2c\xa6\xf2\x56\xc1\xf1\x91\xd2\xb7\xd0\x50\x8a\x08\x98\xdc\xda\xa9\x24\x4b\x26\x26\x9d\xf0\x99\xef\x05\xa4\x38\x1d\x8b\xd4\xb8\xae\xb0\x50\xfe\xd7\xa9\x73\xd9\xc1\xde\xde\x7c\x3e\xef\x4d\xd2\xa2\x67\xf2\xc9\x5e\xe2\xc5\xd9\xbd\xb7\xbd\x16\xc9\x8c\x64\x92\x0c\x73\x19\x61\x63\x38\x47\x0a\xd8\x1c\xe6\x4f\xcc\x1c\xf6\x84\x05\xad\x8c\xc8\xd5\xf4\x3b\x62\x30\xc2\x49\xea\x3b\x3d\x39\x4b\xa0\xc5\x79\x32\x93\xd3\xef\x24\x29\x71\xa6\x53\x20\x22\xc5\x09\x48\xb6\x15\x33\x19\x2b\xa0\x10\xb2\x6b\x02\xbb\xf5\xc3\x10\x8c\xbc\xbb\xc1\x0b\x43\xce\x18\x96\xbd\xd6\x8f\xd6\x4e\xd0\xd0\x3a\x19\xdd\x90\x82\x24\x3f\x2a\xf2\x5c\xa5\x8e\x4c\x59\x00\x75\x30\x2a\x91\x08\x4f\x13\xec\x39\xf8\xfd\x37\xe8\x09\x02\x2f\x69\xa7\x12\x72\x20\xbe\xfc\xb8\xfb\xda\x6d\xb1\xe8\x58\x59\x58\x23\x86\x37\xe8\x44\x37\x56\xcc\xa7\x6c\x51\x31\x57\xbb\x10\xfb\xad\xb0\xae\x46\x33\xce\xcd\x0c\xba\x0a\x00\x8e\x4c\x51\xb3\x0e\x4e\x6c\x58\xa0\xa4\xdf\x70\x1f\x6b\x84\x6d\x2b\xe6\x03\x31\x96\x09\x22\xc9\xef\x6b\x9d\xca\xe8\x34\x3a\xbd\x35\x37\x24\x19\xe0\x01\x84\x11\x20\x26\x8b\x4c\x1c\x82\x81\xce\x51\x1d\x43\x01\x51\x3b\xc4\x07\x49\x45\xca\xdb\xb6\x13\x33\xe9\x8a\x78\xd4\x11\x30\x14\x89\x3d\x96\x99\x2b\x00\x41\xb2\xa7\xca\x73\x24\x34\xc4\xc3\x0c\x99\x06\x21\x9a\x2c\x40\x73\x2b\x73\xbf\x20\x8e\x04\x98\x7b\x13\xe5\x06\xf4\xd8\xee\x1c\x62\x55\x8f\x45\xdb\xaf\x3e\x39\x3a\xe2\xec\x33\xd6\xa9\x8a\xbd\xf8\x1d\x87\xbc\xd8\x1b\xcb\x22\x71\xd5\xbe\xc4\xb4\x93\x2b\xec\x99\xd2\xcf\x3b\xaf\xc5\x27\x25\x4c\x9a\x2c\x60\x02\x52\x65\x44\xe1\x69\x17\xd0\x7c\x16\x0e\x67\xbb\xb0\x85\x25\x13\x62\xc3\xb9\x12\x59\xae\x5e\x44\x53\x45\xbe\x4b\x23\x15\xb4\x04\x07\x3b\xf5\x48\xd0\x6e\x3d\x93\xf5\x9c\xf9\x58\xcc\x46\x0a\xba\x8a\x67\x62\xff\xfb\x78\xbf\x23\xa0\x25\xfd\x28\x75\x0f\x3c\x41\x5f\x92\x62\xb2\x70\x50\xe6\xbf\x42\xde\x49\x27\xfe\xac\x41\x57\x44\x8b\x14\xa9\x9a\x23\x16\x53\x06\x35\x79\x65\xa4\x40\x26\xa2\x5c\xc1\x6c\x31\x80\x1a\x03\x1e\xc6\x23\xaf\xc2\xd9\xea\x96\xe2\xd9\x33\xde\xeb\x48\xec\x1e\x5f\x0e\xfa\xc3\xc1\x6e\x4d\x09\x9d\x9e\x8f\xc7\x41\x0f\xe6\xed\x65\x4a\xdd\xb4\x5f\x76\x7a\xb7\x32\x29\xd4\xf9\xd8\x6b\x14\x68\x07\x88\xa9\xa3\xc0\xf3\x7c\x9d\xe7\xd5\x0a\x0f\x31\xe1\x0c\x7d\x64\x8d\xd9\x28\x51\x9b\xb1\x17\x82\x93\xe3\xd4\x3a\x4a\x4e\x04\xb4\xc8\x20\x47\x2a\x02\x50\xb9\x6b\xb0\x34\x6b\xbc\xe3\x16\x19\xea\x14\xfe\x4c\xd6\xe5\x17\x04\x7b\x7e\xe1\xcc\x07\xf5\x9d\xdd\x51\x5a\x8b\x00\xd4\x8f\xe3\x1c\x89\xab\xdd\xe9\x78\x72\x9d\x66\x85\x3b\x58\x21\x9f\x29\x64\xc6\x45\xcf\x52\xee\x69\xf3\xd1\xba\xfe\xa4\x25\xcf\x44\xda\xd3\x94\x78\x02\x28\xdf\x4b\xc8\xab\x96\x8e\x8d\x85\xc0\xb0\x44\x0f\xe5\x1a\xdb\x82\xd8\x76\xf7\xbf\xef\x6e\x5a\x6b\xbf\xb3\x74\xfa\xcb\x37\x1d\x62\xb9\x3b\xac\xa0\x5c\x65\x84\x5e\x56\xd8\x69\x9b\x91\xb3\x5c\x5d\x46\xfd\x11\x22\xbd\x50\x8d\x48\x67\xf4\x6c\x22\xc7\xaa\x64\x4c\x69\x03\x7c\x11\x23\x68\x22\x39\xa9\x70\x50\x4b\x4a\xb2\xb6\x18\xb1\xcd\x9d\x31\xf7\x02\xe9\x6a\x70\x76\xf2\x6e\x70\x35\xbc\xbc\x3e\x1e\xd6\xe1\x94\xa8\xb1\x23\xa5\x56\xcf\x90\xa8\x74\xe2\xa6\xac\x3f\x89\x5b\x5d\xfd\x42\x3c\x2f\x5e\x7e\xf5\x6f\x20\x7d\x33\xba\x77\x1e\xe6\x10\x5f\xbe\xb2\xec\xbb\xd6\x23\xa4\xde\x98\x3f\x3c\x88\x4c\x76\x57\xcf\x11\x0d\x61\x37\x43\xba\x35\x31\xe7\xc1\x48\xfa\x54\x5a\x5a\x31\x36\xa9\xda\x3a\xf8\xda\x65\xf4\xf5\xcf\xce\x76\xc5\x1f\x7f\x88\xda\xf3\xf1\xf9\xbb\x41\xfd\xdd\xbb\xc1\xd9\xe0\x3d\x62\x74\x9d\xf6\x6a\xd8\x47\x0b\xc0\x6f\x3b\xc1\x2a\x50\xf5\xea\x46\x67\x9c\x50\x39\x4d\x21\x74\xb8\x33\xac\xf4\x45\x32\xc3\x09\xa8\xe7\xca\x43\xbd\x18\xcb\x34\x2a\xf3\xb8\x2d\x9d\x86\x23\xc0\x65\xa6\x8c\x95\xcd\x54\x50\x07\x6a\xa7\x72\xa3\xb6\x17\x28\x72\x7e\xd3\xb8\xed\x4c\xa9\xd7\xd2\xa0\xde\x23\x9c\xeb\x38\xc9\xb4\xb7\x3f\xa4\xf8\x9b\xd8\x17\x07\xe2\x65\xc8\x24\x0f\xa4\xaa\x57\x88\x2d\x88\xff\x89\x84\xf5\xba\x81\xf3\xcf\x99\xb6\x9c\x61\xe2\x92\x1c\xb6\xfe\x9f\xa7\x33\x54\x4a\xc8\x3a\x10\xeb\x46\xfc\x65\xc3\x88\x15\xfd\x99\x4a\x37\xe9\xff\xb2\x41\xbf\x4c\x7d\x84\x2a\x40\xe1\xc9\x06\x44\x7c\xe2\x79\xb2\x16\x07\xc1\xb8\xdc\xcd\xb0\x34\xd8\xbb\x39\xd9\xbe\x5a\xc5\xf0\x7d\xd9\xe2\x3f\x4a\xb6\x8d\x5d\x19\xf5\x5e\xab\x7d\x57\x17\x00\x82\x22\x68\xa8\x30\x4f\xec\x5a\x16\x49\xfd\xa9\x99\x23\x34\x55\x0f\x0d\x8a\x97\x98\x2a\xc5\xc9\x25\xf4\xb3\xd4\x8e\x70\x8b\x47\x3d\x69\x98\x4c\x18\x62\x92\xdb\x4e\xc0\x70\x26\x17\x34\x99\xa0\xff\xba\x59\x20\xa9\x63\x96\x59\xa4\x72\xa6\x23\xeb\xe5\x71\x2f\x9b\xab\x89\xcc\x59\x6c\xae\xfe\x51\xa0\x08\x50\xab\x0f\x20\x63\x83\x02\xc2\xc0\xa7\x69\x56\x21\xee\xf6\xab\xd7\xfb\xfb\x40\xb8\xce\x70\x92\xae\x78\xf3\x7a\xef\xcd\x2f\x22\x2f\x12\xd5\xe9\xb5\x6a\x69\xbc\x3a\x6a\xf0\x06\x2d\x04\xf4\xbc\x53\x99\x9b\xa2\x21\x7a\x7b\x4f\x3d\xb8\x27\xb9\x37\xd2\x8a\x17\x02\x49\x9c\xf4\x3a\x5a\xc1\xad\xf7\xa4\x50\xe8\x5e\x83\x34\x9a\xef\xce\xdf\x9d\xb7\x6f\x24\xc6\x14\x39\x52\x9d\x03\x9e\xf7\xd8\x56\x73\x19\x1a\x7e\x72\x8a\xc8\x12\x09\x43\xca\x28\xc2\xac\xe9\xc8\xf0\x65\xef\x0e\x3b\x20\xbf\xef\xba\x52\x1e\x8f\x46\xa0\x43\x44\x96\xe9\x9e\xbd\x46\xea\xc8\x19\x71\xc3\xbf\x56\xc7\xaa\xe6\x15\xca\x0e\x86\x53\x73\xa0\xa0\xc9\xb1\x14\x38\x43\x5c\x25\xec\xad\x79\x4e\x73\x86\xd5\x70\x3d\x8d\x97\xb1\x22\x6b\x63\x98\x86\x5e\x38\x27\x4f\xf7\x1c\xe3\xc8\xe0\x13\xdb\xf3\xf9\x9e\xb6\xa5\x9c\x93\x9a\x79\x6f\x15\xc8\x75\xa8\x72\x47\xbf\xd6\x0e\xa4\x40\x13\x06\x5c\x6e\x20\x49\x4b\x94\x33\x8f\x64\xbc\xe9\x8a\x0c\x21\x46\x79\x7a\xcb\x5e\xf2\x72\xf0\xfb\xe0\xb2\x2a\xfe\xdb\x3b\xb1\x6c\xf1\x9f\x56\x13\x10\x94\xc0\x78\x01\x2c\x3e\x6d\xe8\xd9\x1b\x00\x75\x74\x0f\xa0\x48\xfe\xb2\x36\x5e\xd4\x8e\x93\xa0\xa5\x5f\x3a\x06\xa2\xf8\x6d\x5d\x01\x8b\xd1\xc1\xae\xe5\xee\xf5\xe4\x60\xb2\xb2\x42\x90\x52\x9c\x76\x28\xb1\x37\x74\xd6\xc1\xe0\xae\x0e\x3c\x29\x3c\x4d\x2d\x01\xf0\x7a\xd9\xa1\x49\x9f\xf3\x59\x43\x24\x4f\x72\x3a\x55\xe9\x65\x8a\x83\xdf\xaf\x2d\xfb\x36\x24\xb9\x91\x9e\x9c\xa6\xae\x5d\x2e\x9e\xa6\x30\x40\xf9\x40\xa9\x1b\x8f\xf5\x58\x69\xc8\x81\x18\x01\x51\xb5\x94\x58\x8a\x38\x14\x6b\xaf\x48\x90\x3f\x34\x9b\x06\xba\x6f\x96\xe0\xfd\x20\x8d\xcc\xf2\x04\x14\x3d\x24\x17\xc0\x0f\xef\x4b\x7b\xf8\x13\x20\x78\xe8\xef\xa8\x2a\x63\x65\x9d\x23\x9e\x95\x26\x23\x08\xf4\x6c\xc1\x1a\x25\x5b\x3c\xf2\xb5\x29\x56\x0f\x4a\x08\x22\x42\x72\xa8\x3c\x16\xe0\xd7\xd4\x65\xee\xd4\x09\xc4\xd3\xaa\xec\x8f\xa5\x4e\x30\xb9\x3e\x3d\x14\x0d\xc9\xc5\x16\xf9\x58\x46\xec\x4b\xba\x68\xa1\x11\xd4\x22\xf4\x67\x6a\x6a\xe6\x5e\x81\xa6\x14\xb5\x09\x8e\x0a\x07\x6b\x45\x82\xef\x52\x40\x51\x58\x39\x51\x35\x70\x54\x06\x2f\x1d\xd5\x38\x17\xff\x34\x74\x9e\x57\x8f\x8f\xa0\xc8\xef\xf2\x28\x34\x1e\xc2\x46\xa3\x97\x37\x7a\x99\x92\x88\x3b\x9a\xda\x43\xa9\xaa\x6f\x38\x2a\xe4\xfc\x3b\x7e\xff\xef\x38\xde\x7b\x3e\xfc\xbb\x6d\xa0\xad\xd3\xfa\x33\xae\x12\xfb\x93\x2e\x9b\x98\xc7\x51\x50\xad\xde\x07\x80\xfb\xfa\x23\x82\x6a\xfa\x4d\x45\x6e\x09\x57\x6e\x69\xe8\x09\x33\xc7\xad\x36\x05\x55\x2b\xf5\xff\x34\xff\x55\xfd\x1d\xe8\xef\xc2\x9d\x17\xbb\xaf\x7e\xe9\x35\x9f\x86\x3b\x5b\xdf\x1a\xd5\x6a\x85\xe1\x42\x1a\xae\xc2\xc6\xfe\x36\x75\x87\xf9\x1f\xb8\xfc\x0a\xf1\xee\x4c\x46\xb5\x3f\x94\xa2\x24\x57\x32\x5e\x54\xd5\xaf\xeb\xbb\x0e\xb4\x1b\x69\x1c\x26\x0f\xd4\x04\x4d\xf2\x18\x8b\xa4\xa1\x9c\xa0\x67\x69\x35\x9a\xf1\xd1\x92\xdb\x84\x8c\x8d\x46\xb6\x5e\x35\xc3\xc4\x48\xe3\x1d\x6b\xdc\xda\xa2\x3a\xae\xc5\xd2\xfa\x3d\x5e\xb8\x0a\xc4\x68\x5a\xcc\xb8\xed\x15\xf2\x16\x1b\x48\x1a\xb5\xb8\x9d\x42\x7e\x8b\x12\x05\x03\xf3\xed\x3d\x9c\x67\xe8\xf2\xbe\xb5\x05\xc8\x7f\x06\xe3\x6b\xc9\xb1\x7c\x0c\xe6\xd8\x3e\x66\xb7\x8d\x58\x7f\xfc\x93\x44\x3a\x17\xe0\x55\x33\xaf\x8f\x2c\xed\xf8\xc3\x0e\xda\xd0\xd6\x76\x21\xc5\x0d\x12\xd1\xbc\x15\xfb\xb5\x26\xfc\xcf\x12\x64\x9b\x10\x3b\xab\x9a\xb1\x70\x78\x67\x4c\x17\xc7\x94\x3c\x12\x95\x9f\x5d\xca\xe6\xf3\xa1\x09\xad\x8c\x5e\xdf\xbe\x6d\x84\x2f\x5f\x62\x41\x54\xb8\xee\xf0\x7d\xfc\x48\x61\x45\x23\xc1\xd3\xfd\xa9\x20\x74\x85\x2f\x05\xa4\xa5\x65\x71\xec\x17\x4d\x41\x17\x04\x87\x6b\x7b\xaa\xcf\x40\x0f\xc2\xdd\xbf\xaf\xc5\x7b\xe4\xbe\x2f\xe3\xdd\x17\x43\xe6\x0c\x17\x00\xd5\xfc\x0f\x3a\xee\x19\x79\x46\x5e\xbb\x04\xa0\x35\x7a\xe5\x07\xe8\xb5\x91\x9f\x19\xc3\xd8\xbf\x7e\xb3\x48\x6b\xfc\x6e\x05\xe0\x4c\x0a\x8c\x7a\x31\x6b\x21\x01\x8e\x8d\x88\x28\x19\x28\x18\x0e\x9a\x19\x68\xa9\x81\x69\xed\x1a\x82\x88\xf9\x95\x5f\xf5\x85\xfd\xa0\xbe\xea\x5f\x85\x83\xea\x59\xcd\x36\x78\xa0\xb7\x77\x87\xcd\x49\x6e\xbf\xc4\x63\x73\x32\x23\x9b\x57\x80\xbd\x87\xb5\x3e\x58\x6c\x92\x3c\x94\x2a\x59\x7a\x99\xd9\xee\x61\x65\xe9\xb5\xd6\x03\x67\xda\x5a\x64\x45\x5c\x57\x71\x85\xa6\x49\x48\xc8\x33\x81\xce\x5b\xb6\x14\xe0\x51\xed\x75\x65\x44\xeb\x7f\xaa\x20\xb1\x1e\x3f\xe5\x12\x7d\xb4\xe2\x0f\x0b\xdc\x90\x52\xf8\x98\x11\x17\xff\xc2\xd2\xcc\xb8\x8c\x0b\x44\x93\xce\xe9\xd3\x90\x56\x09\x82\x88\xbe\x04\xd3\x44\xfa\xcd\xd2\xfd\x17\x7d\x42\x52\xb9\x26\x89\xfe\x53\x99\xff\x6a\xcd\x1f\xf0\x52\x74\x72\x6e\x21\xc6\xd8\x84\xbe\x05\x21\xdf\x65\x12\x33\xcf\x0c\x19\x1f\x3b\xd0\xe7\xbd\x85\x30\x39\xe4\xa9\x78\x39\x94\x51\x48\x1a\xfa\x06\x97\xd3\x37\x30\x13\xca\x24\x77\x69\x19\x35\x9d\xda\x75\xc3\xbd\x8b\xb6\x18\xea\x17\x78\x41\x25\x39\x1c\xaa\x1e\xa5\xd5\x07\x18\xfe\x8a\x63\xa8\xea\x6e\x86\x68\x39\xd7\xad\xc6\x28\xbf\xa6\xa7\xd5\xe8\x0c\x73\xcd\x6a\x5c\x2e\x6f\xa4\x56\x83\xb0\x2c\x1b\xab\x91\x56\x2f\x42\xab\xe1\xc4\x2b\xfc\xb4\x1a\x48\xb5\x7e\x99\x17\x18\x1c\x15\x03\x3f\xad\x85\x16\x6b\x19\x62\xcb\x7f\x6e\xac\xc8\xf9\xa9\x1b\x00\x43\x5e\x6c\x93\x71\x6e\xd4\x82\x32\xb1\xb7\x51\xad\xac\xf8\x17\x5f\xb0\xfc\xb5\xb9\x8a\x04\x38\xd6\xe8\xaa\xb2\x51\x42\xda\xaf\x3d\x10\xc8\x95\x16\xfa\x68\xff\x50\xe8\x5f\xeb\x0c\x65\xe5\x13\xfa\xf9\xf3\x72\xcf\xfa\xfa\x17\xfd\xb5\x8c\xce\x0a\xf1\x6b\xeb\x9d\x15\x8d\x42\x8c\x78\x1a\x0a\x8a\xd6\x5d\xeb\x5f\xb5\x25\x8b\x4d\x94\x21\x00\x00")
 
 func call_tracer_originalJsBytes() ([]byte, error) {
 	return bindataRead(
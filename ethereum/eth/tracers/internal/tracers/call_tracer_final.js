@@ -28,12 +28,56 @@
     localVariables:[],
     stateVariablesInitiated: [],
     stateVariables:[],
+    storageAccessed: [],
     prevPC: 0,
 
+    // opcodes counts the opcodes executed before the first call frame is known.
+    opcodes: 0,
+
     // descended tracks whether we've just descended from an outer transaction into
     // an inner call.
     descended:false,
 
+    // decodeParameters decodes the parameters of a function from the top of the
+    // stack, where the last one is pushed last. Every parameter takes as many
+    // stack slots as its type needs, reference types are read from memory or
+    // calldata. The raw stack words are returned as well, in stack order.
+    decodeParameters: function (log, parameters) {
+        var encoded = "";
+        var values = [];
+        var stackPosition = 0;
+        for (var i = parameters.length - 1; i >= 0; i--) {
+            var typeIdentifier = parameters[i].typeName.typeDescription.typeIdentifier;
+            var size = log.getStackSize(typeIdentifier);
+            for (var j = 0; j < size; j++) {
+                encoded += stringToHex(log.stack.peek(stackPosition + j).toString());
+            }
+            values.unshift({
+                name: parameters[i].name,
+                type: parameters[i].typeName.typeDescription.typeString,
+                value: JSON.parse(log.decode(typeIdentifier, stackPosition)),
+            });
+            stackPosition += size;
+        }
+
+        return {encoded: encoded, values: values};
+    },
+
+    // updateStateVariables decodes again the state variables of the current
+    // contract kept at the slot, replacing their previously decoded values.
+    updateStateVariables: function (log, slot) {
+        var variables = this.stateVariables[toHex(log.contract.getAddress())];
+        var updated = JSON.parse(log.getSlotVariables(slot)) || [];
+        for (var i = 0; i < updated.length; i++) {
+            for (var j = 0; j < variables.length; j++) {
+                if (variables[j].name == updated[i].name && variables[j].contract == updated[i].contract) {
+                    variables[j] = updated[i];
+                    break;
+                }
+            }
+        }
+    },
+
     // step is invoked for every opcode that the VM executes.
     step:
 
@@ -45,45 +89,38 @@
             return;
         }
 
+        // Count the opcode towards the innermost call frame.
+        if (this.callstack.length > 0) {
+            var top = this.callstack[this.callstack.length - 1];
+            top.opcodes = (top.opcodes || 0) + 1;
+        } else {
+            this.opcodes++;
+        }
+
         if (this.jumpdestMethod[toHex(log.contract.getAddress())] == null) {
             this.jumpdestMethod[toHex(log.contract.getAddress())] = []
         }
 
+        // State variables are decoded from storage on the first step in a
+        // contract. After a storage access, which is also when new mapping
+        // keys show up, only the variables kept at the accessed slot are.
         if (!this.stateVariablesInitiated[toHex(log.contract.getAddress())]) {
-            this.stateVariables[toHex(log.contract.getAddress())] = []
-            var svJson = log.getStateVariables();
-            if (svJson != "null") {
-                var sv = JSON.parse(svJson);
-            }
-
-            var index = 0;
-            for (var i = 0; i < sv.length; i++) {
-                switch (sv[i].typeName.typeDescription.typeIdentifier) {
-                    case "t_uint256":
-                        this.stateVariables[toHex(log.contract.getAddress())].push({
-                            name: sv[i].name,
-                            value: toHex(log.db.getState(log.contract.getAddress(), index)),
-                            index: index,
-                        });
-                        index++;
-                        break;
-                }
-            }
-
+            this.stateVariables[toHex(log.contract.getAddress())] = JSON.parse(log.getStorageVariables()) || [];
             this.stateVariablesInitiated[toHex(log.contract.getAddress())] = true;
-
-        } else {
-            for (var i = 0; i < this.stateVariables[toHex(log.contract.getAddress())].length; i++) {
-                var index = this.stateVariables[toHex(log.contract.getAddress())][i].index;
-                this.stateVariables[toHex(log.contract.getAddress())][i].value = toHex(log.db.getState(log.contract.getAddress(), index));
-            }
+            this.storageAccessed[toHex(log.contract.getAddress())] = undefined;
+        } else if (this.storageAccessed[toHex(log.contract.getAddress())] !== undefined) {
+            this.updateStateVariables(log, this.storageAccessed[toHex(log.contract.getAddress())]);
+            this.storageAccessed[toHex(log.contract.getAddress())] = undefined;
+        }
+        if (log.op.toString() == "SLOAD" || log.op.toString() == "SSTORE") {
+            this.storageAccessed[toHex(log.contract.getAddress())] = toWord('0x' + log.stack.peek(0).toString(16));
         }
 
         // go thought current contract and method, not all of them
         for (var j in this.localVariables[toHex(log.contract.getAddress())]) {
             for (var k in this.localVariables[toHex(log.contract.getAddress())][j]) {
                 if (this.localVariables[toHex(log.contract.getAddress())][j][k].position >= log.getOpCodeStackChange(this.localVariables[toHex(log.contract.getAddress())][j][k].position) && this.localVariables[toHex(log.contract.getAddress())][j][k].lifetime) {
-                    this.localVariables[toHex(log.contract.getAddress())][j][k].value = log.stack.peek(this.localVariables[toHex(log.contract.getAddress())][j][k].position)
+                    this.localVariables[toHex(log.contract.getAddress())][j][k].value = JSON.parse(log.decode(this.localVariables[toHex(log.contract.getAddress())][j][k].typeIdentifier, this.localVariables[toHex(log.contract.getAddress())][j][k].position))
                     this.localVariables[toHex(log.contract.getAddress())][j][k].position -= log.getOpCodeStackChange(this.localVariables[toHex(log.contract.getAddress())][j][k].position)
                 } else {
                     this.localVariables[toHex(log.contract.getAddress())][j][k].lifetime = false;
@@ -93,6 +130,58 @@
         var syscall = (log.op.toNumber() & 0xf0) == 0xf0;
         var op = log.op.toString();
         var pc = log.getPC();
+
+        // Record the storage accesses of the innermost frame, with the slots
+        // resolved to the state variables they hold.
+        if ((op == "SLOAD" || op == "SSTORE") && this.callstack.length > 0) {
+            var slot = toWord('0x' + log.stack.peek(0).toString(16));
+            var access = {
+                type: op,
+                slot: toHex(slot),
+                expression: log.getSlotExpression(slot) || undefined,
+            };
+            if (op == "SSTORE") {
+                access.value = toHex(toWord('0x' + log.stack.peek(1).toString(16)));
+            } else {
+                access.value = toHex(log.db.getState(log.contract.getAddress(), slot));
+            }
+
+            var frame = this.callstack[this.callstack.length - 1];
+            if (frame.storage === undefined) {
+                frame.storage = [];
+            }
+            frame.storage.push(access);
+        }
+        // Record the logs emitted by the innermost frame, decoded against the
+        // events of the emitting code
+        if (op.indexOf("LOG") == 0 && this.callstack.length > 0) {
+            var logOff = log.stack.peek(0).valueOf();
+            var logEnd = logOff + log.stack.peek(1).valueOf();
+            var data = log.memory.slice(logOff, logEnd);
+            var topics = [];
+            for (var t = 0; t < log.op.toNumber() - 0xa0; t++) {
+                topics.push(toHex(toWord('0x' + log.stack.peek(2 + t).toString(16))));
+            }
+
+            var emitted = {
+                address: toHex(log.contract.getAddress()),
+                topics: topics,
+                data: toHex(data),
+            };
+            var decodedLog = JSON.parse(log.decodeLog(JSON.stringify(topics), data));
+            if (decodedLog !== null) {
+                emitted.name = decodedLog.name;
+                emitted.signature = decodedLog.signature;
+                emitted.decodedInputs = decodedLog.inputs;
+                emitted.candidates = decodedLog.candidates;
+            }
+
+            var emitter = this.callstack[this.callstack.length - 1];
+            if (emitter.logs === undefined) {
+                emitter.logs = [];
+            }
+            emitter.logs.push(emitted);
+        }
         var astJson = log.getAst(pc);
         if (astJson != "null") {
             var ast = JSON.parse(astJson);
@@ -104,20 +193,9 @@
             var output = "";
             var decodedOutput = [];
             if (ast.returnParameters.parameters != null) {
-                var stackPosition = 0;
-                var decodedOutput = [];
-                for (var i = 0; i < ast.returnParameters.parameters.length; i++) {
-                    switch (ast.returnParameters.parameters[i].typeName.typeDescription.typeIdentifier) {
-                        case "t_uint256":
-                            output += output + stringToHex(log.stack.peek(stackPosition).toString());
-                            decodedOutput.push({
-                                name: ast.returnParameters.parameters[i].name,
-                                value: stringToHex(log.stack.peek(stackPosition).toString()),
-                            });
-                            stackPosition++;
-                            break;
-                    }
-                }
+                var decoded = this.decodeParameters(log, ast.returnParameters.parameters);
+                output = decoded.encoded;
+                decodedOutput = decoded.values;
             }
             if (this.callstack.length > 1) {
                 call = this.callstack.pop();
@@ -155,21 +233,9 @@
             if (op == "JUMPDEST" && pc > 100) { // ugly :(
                 if (ast.nodeType == "FunctionDefinition") {
                     if (ast.parameters.parameters != null) {
-                        var input = "";
-                        var decodedInput = [];
-                        var stackPosition = 0;
-                        for (var i = ast.parameters.parameters.length - 1; i >= 0; i--) {
-                            switch (ast.parameters.parameters[i].typeName.typeDescription.typeIdentifier) {
-                                case "t_uint256":
-                                    input += stringToHex(log.stack.peek(stackPosition).toString());
-                                    decodedInput.push({
-                                        name: ast.parameters.parameters[i].name,
-                                        value: stringToHex(log.stack.peek(stackPosition).toString()),
-                                    });
-                                    stackPosition++;
-                                    break;
-                            }
-                        }
+                        var decoded = this.decodeParameters(log, ast.parameters.parameters);
+                        var input = decoded.encoded;
+                        var decodedInput = decoded.values;
                     }
 
                     if (this.jumpdestInit) {
@@ -220,6 +286,8 @@
             if (ast.nodeType == 'VariableDeclaration') {
                 var variable = {
                     name: ast.name,
+                    type: ast.typeName.typeDescription.typeString,
+                    typeIdentifier: ast.typeName.typeDescription.typeIdentifier,
                     position: 0,
                     value: 0,
                     lifetime: true,
@@ -266,13 +334,15 @@
 
             var inOff = log.stack.peek(2 + off).valueOf();
             var inEnd = inOff + log.stack.peek(3 + off).valueOf();
+            var calldata = log.memory.slice(inOff, inEnd);
 
             // Assemble the internal call report and store for completion
             var call = {
                 type: op,
                 from: toHex(log.contract.getAddress()),
                 to: toHex(to),
-                input: toHex(log.memory.slice(inOff, inEnd)),
+                input: toHex(calldata),
+                calldata: calldata,
                 gasIn: log.getGas(),
                 gasCost: log.getCost(),
                 outOff: log.stack.peek(4 + off).valueOf(),
@@ -282,6 +352,16 @@
             if (op != 'DELEGATECALL' && op != 'STATICCALL') {
                 call.value = '0x' + log.stack.peek(2).toString(16);
             }
+            // Decode the call against the ABI of the callee. If the source of
+            // the callee is known, its function decodes the inputs again
+            // once it is entered.
+            var decodedCall = JSON.parse(log.decodeCallInput(to, calldata));
+            if (decodedCall !== null) {
+                call.func = decodedCall.name;
+                call.signature = decodedCall.signature;
+                call.candidates = decodedCall.candidates;
+                call.decodedInput = decodedCall.inputs;
+            }
             this.callstack.push(call);
             this.descended = true
             this.methodDepth[toHex(to)] = this.methodDepth[toHex(log.contract.getAddress())];
@@ -305,6 +385,15 @@
         if (syscall && op == 'REVERT') {
             this.callstack[this.callstack.length - 1].error = "execution reverted";
             this.callstack[this.callstack.length - 1].errorPC = pc;
+
+            // Decode the reason string, panic code or custom error the call
+            // reverts with from the returned memory
+            var revOff = log.stack.peek(0).valueOf();
+            var revEnd = revOff + log.stack.peek(1).valueOf();
+            var revert = JSON.parse(log.decodeRevert(log.memory.slice(revOff, revEnd)));
+            if (revert !== null) {
+                this.callstack[this.callstack.length - 1].revert = revert;
+            }
             return;
         }
 
@@ -332,7 +421,14 @@
                     call.gasUsed = '0x' + bigInt(call.gasIn - call.gasCost + call.gas - log.getGas()).toString(16);
                     var ret = log.stack.peek(0);
                     if (!ret.equals(0)) {
-                        call.output = toHex(log.memory.slice(call.outOff, call.outOff + call.outLen));
+                        var returned = log.memory.slice(call.outOff, call.outOff + call.outLen);
+                        call.output = toHex(returned);
+                        if (call.decodedOutput === undefined && call.calldata !== undefined) {
+                            var decodedReturn = JSON.parse(log.decodeCallOutput(toAddress(call.to), call.calldata, returned));
+                            if (decodedReturn !== null) {
+                                call.decodedOutput = decodedReturn;
+                            }
+                        }
                     } else if (call.error === undefined) {
                         call.error = "internal failure"; // TODO(karalabe): surface these faults somehow
                         call.errorPC = pc;
@@ -342,6 +438,7 @@
                 delete call.gasCost;
                 delete call.outOff;
                 delete call.outLen;
+                delete call.calldata;
             }
             if (call.gas !== undefined) {
                 call.gas = '0x' + bigInt(call.gas).toString(16);
@@ -379,6 +476,7 @@
         delete call.gasCost;
         delete call.outOff;
         delete call.outLen;
+        delete call.calldata;
 
         // Flatten the failed call into its parent
         var left = this.callstack.length;
@@ -399,12 +497,15 @@
         var result = {
             pc: this.callstack[0].pc,
             func: this.callstack[0].func,
+            signature: this.callstack[0].signature,
+            candidates: this.callstack[0].candidates,
             type: this.callstack[0].type,
             from: toHex(ctx.from),
             to: toHex(ctx.to),
             value: '0x' + ctx.value.toString(16),
             gas: '0x' + bigInt(ctx.gas).toString(16),
             gasUsed: '0x' + bigInt(ctx.gasUsed).toString(16),
+            opcodes: (this.callstack[0].opcodes || 0) + this.opcodes,
             input: '0x' + this.callstack[0].input,
             decodedInput: this.callstack[0].decodedInput,
             stateVariables: this.callstack[0].stateVariables,
@@ -412,8 +513,11 @@
             locals: this.callstack[0].locals,
             output: toHex(ctx.output),
             decodedOutput: this.callstack[0].decodedOutput,
+            storage: this.callstack[0].storage,
+            logs: this.callstack[0].logs,
             error: this.callstack[0].error,
             errorPC: this.callstack[0].errorPC,
+            revert: this.callstack[0].revert,
             time: ctx.time,
         };
         if (this.callstack[0].calls !== undefined) {
@@ -439,12 +543,15 @@
         var sorted = {
             pc: call.pc,
             func: call.func,
+            signature: call.signature,
+            candidates: call.candidates,
             type: call.type,
             from: call.from,
             to: call.to,
             value: call.value,
             gas: call.gas,
             gasUsed: call.gasUsed,
+            opcodes: call.opcodes,
             input: call.input,
             decodedInput: call.decodedInput,
             stateVariables: call.stateVariables,
@@ -452,8 +559,11 @@
             parentLocals: call.parentLocals,
             output: call.output,
             decodedOutput: call.decodedOutput,
+            storage: call.storage,
+            logs: call.logs,
             error: call.error,
             errorPC: call.errorPC,
+            revert: call.revert,
             time: call.time,
             calls: call.calls,
         }
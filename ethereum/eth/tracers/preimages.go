@@ -0,0 +1,64 @@
+package tracers
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
+)
+
+// Preimages records the data hashed by the SHA3 opcodes of an execution,
+// keyed by the account whose storage the hashing code runs against. Mapping
+// entries and dynamic array data of a contract live at hashes its own code
+// computed, so the preimages of other accounts are kept apart from them.
+type Preimages struct {
+	accounts map[common.Address]map[common.Hash][]byte
+}
+
+// NewPreimages creates an empty preimage record.
+func NewPreimages() *Preimages {
+	return &Preimages{
+		accounts: make(map[common.Address]map[common.Hash][]byte),
+	}
+}
+
+// Record stores the preimage hashed by the opcode, if it is a SHA3. It is
+// meant to be called from CaptureState, before the opcode is executed.
+func (p *Preimages) Record(op vm.OpCode, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract) {
+	if op != vm.SHA3 {
+		return
+	}
+
+	// Opcodes failing on a stack underflow are captured too.
+	data := stack.Data()
+	if len(data) < 2 {
+		return
+	}
+
+	offset, size := data[len(data)-1], data[len(data)-2]
+	length := int64(memory.Len())
+	if !offset.IsInt64() || !size.IsInt64() || size.Int64() > length || offset.Int64() > length-size.Int64() {
+		return
+	}
+
+	preimage := memory.Get(offset.Int64(), size.Int64())
+	if preimage == nil {
+		preimage = []byte{}
+	}
+
+	// Delegated code hashes against the storage of its caller, which is the
+	// address of the contract.
+	address := contract.Address()
+	if p.accounts[address] == nil {
+		p.accounts[address] = make(map[common.Hash][]byte)
+	}
+	p.accounts[address][crypto.Keccak256Hash(preimage)] = preimage
+}
+
+// Of returns the preimages hashed against the storage of the account.
+func (p *Preimages) Of(address common.Address) map[common.Hash][]byte {
+	if p == nil {
+		return nil
+	}
+
+	return p.accounts[address]
+}
@@ -0,0 +1,144 @@
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tenderly/tenderly-trace/ethereum/core"
+	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
+)
+
+// PrestateTracerName is the name the prestate tracer is registered under.
+const PrestateTracerName = "prestateTracer"
+
+func init() {
+	Register(PrestateTracerName, newPrestateTracer)
+}
+
+// PrestateAccount is the state of an account before the traced transaction,
+// limited to the storage slots the transaction accessed.
+type PrestateAccount struct {
+	Balance *hexutil.Big                `json:"balance"`
+	Nonce   uint64                      `json:"nonce"`
+	Code    hexutil.Bytes               `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+// prestateTracer collects every account and storage slot touched by a
+// transaction together with its value before the transaction was applied,
+// the same way geth's prestateTracer does.
+type prestateTracer struct {
+	env      *vm.EVM
+	prestate map[common.Address]*PrestateAccount
+	create   bool
+	to       common.Address
+}
+
+func newPrestateTracer(config json.RawMessage) (ResultTracer, error) {
+	return &prestateTracer{
+		prestate: make(map[common.Address]*PrestateAccount),
+	}, nil
+}
+
+// CaptureStart records the sender, the recipient and the coinbase. By the time
+// it is called the gas was already bought, the nonce increased and the value
+// transferred, so those are reverted on the recorded balances and nonce.
+func (t *prestateTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	t.env = env
+	t.create = create
+	t.to = to
+
+	t.lookupAccount(from)
+	t.lookupAccount(to)
+	t.lookupAccount(env.Coinbase)
+
+	intrinsicGas, err := core.IntrinsicGas(input, create, env.ChainConfig().IsHomestead(env.BlockNumber))
+	if err != nil {
+		return err
+	}
+	gasCost := new(big.Int).Mul(env.GasPrice, new(big.Int).SetUint64(gas+intrinsicGas))
+
+	toBalance := t.prestate[to].Balance.ToInt()
+	toBalance.Sub(toBalance, value)
+
+	fromBalance := t.prestate[from].Balance.ToInt()
+	fromBalance.Add(fromBalance, new(big.Int).Add(value, gasCost))
+
+	if t.prestate[from].Nonce > 0 {
+		t.prestate[from].Nonce--
+	}
+
+	return nil
+}
+
+// CaptureState records the accounts and storage slots accessed by the opcode
+// about to be executed.
+func (t *prestateTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if err != nil {
+		return nil
+	}
+
+	stackLen := len(stack.Data())
+	switch {
+	case stackLen >= 1 && (op == vm.SLOAD || op == vm.SSTORE):
+		t.lookupStorage(contract.Address(), common.BigToHash(stack.Back(0)))
+	case stackLen >= 1 && (op == vm.EXTCODECOPY || op == vm.EXTCODESIZE || op == vm.BALANCE || op == vm.SELFDESTRUCT):
+		t.lookupAccount(common.BigToAddress(stack.Back(0)))
+	case stackLen >= 2 && (op == vm.CALL || op == vm.CALLCODE || op == vm.DELEGATECALL || op == vm.STATICCALL):
+		t.lookupAccount(common.BigToAddress(stack.Back(1)))
+	case op == vm.CREATE:
+		nonce := env.StateDB.GetNonce(contract.Address())
+		t.lookupAccount(crypto.CreateAddress(contract.Address(), nonce))
+	}
+
+	return nil
+}
+
+func (t *prestateTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	return nil
+}
+
+func (t *prestateTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	// The created contract didn't exist before the transaction.
+	if t.create {
+		delete(t.prestate, t.to)
+	}
+
+	return nil
+}
+
+// GetResult returns the collected accounts keyed by their address.
+func (t *prestateTracer) GetResult() (json.RawMessage, error) {
+	return json.Marshal(t.prestate)
+}
+
+// lookupAccount records the current state of the account unless it was
+// already recorded.
+func (t *prestateTracer) lookupAccount(addr common.Address) {
+	if _, ok := t.prestate[addr]; ok {
+		return
+	}
+
+	t.prestate[addr] = &PrestateAccount{
+		Balance: (*hexutil.Big)(t.env.StateDB.GetBalance(addr)),
+		Nonce:   t.env.StateDB.GetNonce(addr),
+		Code:    t.env.StateDB.GetCode(addr),
+		Storage: make(map[common.Hash]common.Hash),
+	}
+}
+
+// lookupStorage records the current value of the storage slot unless it was
+// already recorded. Since it happens on the first access of the slot, the
+// value is the one before the transaction.
+func (t *prestateTracer) lookupStorage(addr common.Address, key common.Hash) {
+	t.lookupAccount(addr)
+	if _, ok := t.prestate[addr].Storage[key]; ok {
+		return
+	}
+
+	t.prestate[addr].Storage[key] = t.env.StateDB.GetState(addr, key)
+}
@@ -0,0 +1,142 @@
+package tracers
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/tenderly/tenderly-trace/ethereum/core"
+	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
+)
+
+var (
+	prestateSender   = common.HexToAddress("0xaa")
+	prestateCoinbase = common.HexToAddress("0xcc")
+)
+
+// tracePrestate applies a message from prestateSender to the given accounts,
+// which hold the sender and the coinbase too, and returns the prestate the
+// tracer collected.
+func tracePrestate(t *testing.T, alloc map[common.Address]*genesisAccount, to *common.Address, value int64, data []byte) map[common.Address]*PrestateAccount {
+	t.Helper()
+
+	alloc[prestateSender] = &genesisAccount{Balance: (*math.HexOrDecimal256)(big.NewInt(1e18)), Nonce: 5}
+	alloc[prestateCoinbase] = &genesisAccount{Balance: (*math.HexOrDecimal256)(big.NewInt(3))}
+
+	tracer, err := NewTracer(PrestateTracerName, nil)
+	if err != nil {
+		t.Fatalf("failed to create prestate tracer: %v", err)
+	}
+
+	context := vm.Context{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		Origin:      prestateSender,
+		Coinbase:    prestateCoinbase,
+		BlockNumber: big.NewInt(1),
+		Time:        big.NewInt(1),
+		Difficulty:  big.NewInt(1),
+		GasLimit:    1000000,
+		GasPrice:    big.NewInt(2),
+	}
+	evm := vm.NewEVM(context, newMemoryState(alloc), params.TestChainConfig, vm.Config{Debug: true, Tracer: tracer})
+
+	msg := types.NewMessage(prestateSender, to, 5, big.NewInt(value), 100000, big.NewInt(2), data, false)
+	if _, _, _, err := core.NewStateTransition(evm, msg, new(core.GasPool).AddGas(msg.Gas())).TransitionDb(); err != nil {
+		t.Fatalf("failed to execute message: %v", err)
+	}
+
+	result, err := tracer.GetResult()
+	if err != nil {
+		t.Fatalf("failed to retrieve prestate: %v", err)
+	}
+	var prestate map[common.Address]*PrestateAccount
+	if err := json.Unmarshal(result, &prestate); err != nil {
+		t.Fatalf("failed to unmarshal prestate: %v", err)
+	}
+	return prestate
+}
+
+// checkAccount compares the prestate of the account with its balance, nonce
+// and code.
+func checkAccount(t *testing.T, prestate map[common.Address]*PrestateAccount, address common.Address, balance int64, nonce uint64, code []byte) {
+	t.Helper()
+
+	account := prestate[address]
+	if account == nil {
+		t.Errorf("expected %s in the prestate", address.Hex())
+		return
+	}
+	if account.Balance.ToInt().Cmp(big.NewInt(balance)) != 0 || account.Nonce != nonce || !bytes.Equal(account.Code, code) {
+		t.Errorf("expected %s with balance %d, nonce %d and code %x, got %s, %d and %x",
+			address.Hex(), balance, nonce, code, account.Balance, account.Nonce, account.Code)
+	}
+}
+
+// The gas bought, the value transferred and the nonce increased before the
+// tracer starts are reverted on the recorded sender and recipient.
+func TestPrestateTransfer(t *testing.T) {
+	recipient := common.HexToAddress("0xbb")
+	prestate := tracePrestate(t, map[common.Address]*genesisAccount{
+		recipient: {Balance: (*math.HexOrDecimal256)(big.NewInt(7))},
+	}, &recipient, 1000, nil)
+
+	if len(prestate) != 3 {
+		t.Errorf("expected the sender, the recipient and the coinbase, got %d accounts", len(prestate))
+	}
+	checkAccount(t, prestate, prestateSender, 1e18, 5, nil)
+	checkAccount(t, prestate, recipient, 7, 0, nil)
+	checkAccount(t, prestate, prestateCoinbase, 3, 0, nil)
+}
+
+// The created contract didn't exist before the transaction, while the
+// contracts it creates are recorded the way they were before, without nonce
+// and code.
+func TestPrestateCreate(t *testing.T) {
+	// Creates a contract with empty init code and deploys no code itself.
+	initCode := []byte{
+		byte(vm.PUSH1), 0x0, byte(vm.PUSH1), 0x0, byte(vm.PUSH1), 0x0, byte(vm.CREATE), byte(vm.POP),
+		byte(vm.PUSH1), 0x0, byte(vm.PUSH1), 0x0, byte(vm.RETURN),
+	}
+	prestate := tracePrestate(t, map[common.Address]*genesisAccount{}, nil, 0, initCode)
+
+	created := crypto.CreateAddress(prestateSender, 5)
+	if _, ok := prestate[created]; ok {
+		t.Errorf("expected the created contract %s left out", created.Hex())
+	}
+	checkAccount(t, prestate, prestateSender, 1e18, 5, nil)
+
+	// The nonce of the created contract starts at one.
+	child := crypto.CreateAddress(created, 1)
+	checkAccount(t, prestate, child, 0, 0, nil)
+}
+
+// A reverting call still records what it accessed before reverting.
+func TestPrestateRevert(t *testing.T) {
+	contract := common.HexToAddress("0xbb")
+	code := []byte{
+		byte(vm.PUSH1), 0x5, byte(vm.PUSH1), 0x1, byte(vm.SSTORE),
+		byte(vm.PUSH1), 0x0, byte(vm.PUSH1), 0x0, byte(vm.REVERT),
+	}
+	slot := common.BigToHash(big.NewInt(1))
+	prestate := tracePrestate(t, map[common.Address]*genesisAccount{
+		contract: {
+			Balance: (*math.HexOrDecimal256)(big.NewInt(7)),
+			Nonce:   1,
+			Code:    code,
+			Storage: map[common.Hash]common.Hash{slot: common.BigToHash(big.NewInt(9))},
+		},
+	}, &contract, 1000, nil)
+
+	checkAccount(t, prestate, prestateSender, 1e18, 5, nil)
+	checkAccount(t, prestate, contract, 7, 1, code)
+	if value := prestate[contract].Storage[slot]; value != common.BigToHash(big.NewInt(9)) {
+		t.Errorf("expected the slot written before reverting recorded with 9, got %s", value.Hex())
+	}
+}
@@ -0,0 +1,138 @@
+package tracers
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/tenderly/tenderly-trace/ethereum/signer/accounts/abi"
+)
+
+const (
+	// reasonRevert is a revert with a reason string, encoded as Error(string).
+	reasonRevert = "reason"
+	// panicRevert is a failed assert or compiler inserted check, encoded as
+	// Panic(uint256).
+	panicRevert = "panic"
+	// customRevert is a custom error declared in the ABI of the contract.
+	customRevert = "custom"
+	// unknownRevert is revert data which matches none of the above.
+	unknownRevert = "unknown"
+)
+
+// revertReason is the decoded data a call reverted with.
+type revertReason struct {
+	Kind      string         `json:"kind"`
+	Name      string         `json:"name,omitempty"`
+	Signature string         `json:"signature,omitempty"`
+	Message   string         `json:"message,omitempty"`
+	Code      string         `json:"code,omitempty"`
+	Inputs    []*revertInput `json:"inputs,omitempty"`
+	Data      string         `json:"data"`
+}
+
+// revertInput is a decoded input of a custom error.
+type revertInput struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// decodeRevert decodes the data a call reverted with. Custom errors are looked
+// up in the ABI of the reverting contract, which may be nil. Reverts without
+// data, like a bare revert() or require without a reason, return nil.
+func decodeRevert(data []byte, contractAbi *abi.ABI) *revertReason {
+	if len(data) == 0 {
+		return nil
+	}
+
+	reason := &revertReason{
+		Kind: unknownRevert,
+		Data: hexutil.Encode(data),
+	}
+
+	if message, err := abi.UnpackRevert(data); err == nil {
+		reason.Kind = reasonRevert
+		reason.Name = "Error"
+		reason.Signature = "Error(string)"
+		reason.Message = message
+		return reason
+	}
+
+	if code, err := abi.UnpackPanic(data); err == nil {
+		reason.Kind = panicRevert
+		reason.Name = "Panic"
+		reason.Signature = "Panic(uint256)"
+		reason.Code = hexutil.EncodeBig(code)
+		reason.Message = abi.PanicReason(code)
+		return reason
+	}
+
+	if contractAbi == nil {
+		return reason
+	}
+
+	customError, err := contractAbi.ErrorById(data)
+	if err != nil {
+		return reason
+	}
+
+	values, err := customError.Unpack(data)
+	if err != nil {
+		return reason
+	}
+
+	reason.Kind = customRevert
+	reason.Name = customError.Name
+	reason.Signature = customError.Sig()
+	for i, input := range customError.Inputs {
+		reason.Inputs = append(reason.Inputs, &revertInput{
+			Name:  input.Name,
+			Type:  input.Type.String(),
+			Value: formatAbiValue(input.Type, values[i]),
+		})
+	}
+
+	return reason
+}
+
+// formatAbiValue converts a value unpacked by the abi package to the format
+// the rest of the trace uses: numbers as decimal strings and byte arrays,
+// addresses and hashes as hex strings.
+func formatAbiValue(t abi.Type, value interface{}) interface{} {
+	rv := reflect.ValueOf(value)
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		// Small integers are unpacked as native ones, others as big.Int.
+		return fmt.Sprint(value)
+	case abi.AddressTy:
+		return value.(common.Address).Hex()
+	case abi.HashTy:
+		return value.(common.Hash).Hex()
+	case abi.BytesTy:
+		return hexutil.Encode(value.([]byte))
+	case abi.FixedBytesTy, abi.FunctionTy:
+		// Both are unpacked as fixed size arrays of bytes.
+		data := make([]byte, rv.Len())
+		for i := range data {
+			data[i] = byte(rv.Index(i).Uint())
+		}
+		return hexutil.Encode(data)
+	case abi.ArrayTy, abi.SliceTy:
+		values := make([]interface{}, rv.Len())
+		for i := range values {
+			values[i] = formatAbiValue(*t.Elem, rv.Index(i).Interface())
+		}
+		return values
+	case abi.TupleTy:
+		tuple := value.(abi.Tuple)
+		fields := make(abi.Tuple, len(tuple))
+		for i, field := range tuple {
+			fields[i] = abi.TupleField{Name: field.Name, Value: formatAbiValue(*t.TupleElems[i], field.Value)}
+		}
+		return fields
+	}
+
+	return value
+}
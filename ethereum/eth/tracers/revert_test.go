@@ -0,0 +1,147 @@
+package tracers
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tenderly/tenderly-trace/ethereum/signer/accounts/abi"
+)
+
+// errorsAbi declares InsufficientBalance(uint256,uint256) and Unauthorized().
+const errorsAbi = `[
+	{"type": "error", "name": "InsufficientBalance", "inputs": [{"name": "available", "type": "uint256"}, {"name": "required", "type": "uint256"}]},
+	{"type": "error", "name": "Unauthorized", "inputs": []}
+]`
+
+// revertData concatenates the selector of the signature with the words.
+func revertData(signature string, words ...[]byte) []byte {
+	data := crypto.Keccak256([]byte(signature))[:4]
+	for _, word := range words {
+		data = append(data, common.LeftPadBytes(word, 32)...)
+	}
+	return data
+}
+
+func word(x int64) []byte {
+	return big.NewInt(x).Bytes()
+}
+
+func TestDecodeRevert(t *testing.T) {
+	contractAbi, err := abi.JSON(strings.NewReader(errorsAbi))
+	if err != nil {
+		t.Fatalf("failed parsing abi: %s", err)
+	}
+
+	// "not owner" encoded as Error(string): its offset, length and data.
+	reason := revertData("Error(string)", word(32), word(9), common.RightPadBytes([]byte("not owner"), 32))
+
+	tests := []struct {
+		name     string
+		data     []byte
+		abi      *abi.ABI
+		expected *revertReason
+	}{
+		{
+			name:     "reason string",
+			data:     reason,
+			expected: &revertReason{Kind: reasonRevert, Name: "Error", Signature: "Error(string)", Message: "not owner"},
+		},
+		{
+			name:     "assert",
+			data:     revertData("Panic(uint256)", word(0x01)),
+			expected: &revertReason{Kind: panicRevert, Name: "Panic", Signature: "Panic(uint256)", Code: "0x1", Message: "assert(false)"},
+		},
+		{
+			name:     "overflow",
+			data:     revertData("Panic(uint256)", word(0x11)),
+			expected: &revertReason{Kind: panicRevert, Name: "Panic", Signature: "Panic(uint256)", Code: "0x11", Message: "arithmetic overflow or underflow"},
+		},
+		{
+			name:     "division by zero",
+			data:     revertData("Panic(uint256)", word(0x12)),
+			expected: &revertReason{Kind: panicRevert, Name: "Panic", Signature: "Panic(uint256)", Code: "0x12", Message: "division or modulo by zero"},
+		},
+		{
+			name:     "index out of bounds",
+			data:     revertData("Panic(uint256)", word(0x32)),
+			expected: &revertReason{Kind: panicRevert, Name: "Panic", Signature: "Panic(uint256)", Code: "0x32", Message: "array index out of bounds"},
+		},
+		{
+			name:     "unknown panic code",
+			data:     revertData("Panic(uint256)", word(0x99)),
+			expected: &revertReason{Kind: panicRevert, Name: "Panic", Signature: "Panic(uint256)", Code: "0x99", Message: "unknown panic code 0x99"},
+		},
+		{
+			name: "custom error",
+			data: revertData("InsufficientBalance(uint256,uint256)", word(5), word(7)),
+			abi:  &contractAbi,
+			expected: &revertReason{
+				Kind:      customRevert,
+				Name:      "InsufficientBalance",
+				Signature: "InsufficientBalance(uint256,uint256)",
+				Inputs: []*revertInput{
+					{Name: "available", Type: "uint256", Value: "5"},
+					{Name: "required", Type: "uint256", Value: "7"},
+				},
+			},
+		},
+		{
+			name:     "custom error without inputs",
+			data:     revertData("Unauthorized()"),
+			abi:      &contractAbi,
+			expected: &revertReason{Kind: customRevert, Name: "Unauthorized", Signature: "Unauthorized()"},
+		},
+		{
+			name:     "custom error without abi",
+			data:     revertData("Unauthorized()"),
+			expected: &revertReason{Kind: unknownRevert},
+		},
+		{
+			name:     "custom error missing from abi",
+			data:     revertData("Paused()"),
+			abi:      &contractAbi,
+			expected: &revertReason{Kind: unknownRevert},
+		},
+		{
+			name:     "truncated reason string",
+			data:     reason[:4+64+4],
+			expected: &revertReason{Kind: unknownRevert},
+		},
+		{
+			name:     "truncated panic",
+			data:     revertData("Panic(uint256)", word(0x11))[:20],
+			expected: &revertReason{Kind: unknownRevert},
+		},
+		{
+			name:     "truncated custom error",
+			data:     revertData("InsufficientBalance(uint256,uint256)", word(5)),
+			abi:      &contractAbi,
+			expected: &revertReason{Kind: unknownRevert},
+		},
+		{
+			name:     "reason string with a length past the data",
+			data:     revertData("Error(string)", word(32), word(1000), common.RightPadBytes([]byte("not owner"), 32)),
+			expected: &revertReason{Kind: unknownRevert},
+		},
+		{
+			name:     "shorter than a selector",
+			data:     []byte{0x08, 0xc3},
+			expected: &revertReason{Kind: unknownRevert},
+		},
+	}
+
+	for _, test := range tests {
+		test.expected.Data = hexutil.Encode(test.data)
+		if decoded := decodeRevert(test.data, test.abi); encode(t, decoded) != encode(t, test.expected) {
+			t.Errorf("%s: expected %s, got %s", test.name, encode(t, test.expected), encode(t, decoded))
+		}
+	}
+
+	if decoded := decodeRevert(nil, &contractAbi); decoded != nil {
+		t.Errorf("expected no reason for a revert without data, got %s", encode(t, decoded))
+	}
+}
@@ -0,0 +1,295 @@
+package tracers
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tenderly/tenderly-trace/ethereum/core/types"
+)
+
+// maxSlotDistance bounds how far past a hashed slot the slots attributed to
+// it are searched for. Which of the hashes below a slot it belongs to is
+// decided by the types of their variables, see storageDecoder.resolve.
+var maxSlotDistance = big.NewInt(1 << 24)
+
+// location is where a slot lies within the state variables of a contract.
+type location struct {
+	// expression is the source expression of the variable kept at the slot.
+	expression string
+	// key is the type of the variable starting at the slot, which is empty
+	// when the slot holds several packed variables.
+	key string
+	// owners are the state variables whose storage holds the slot.
+	owners []*types.StorageVariable
+}
+
+// candidate is a hash a slot may be attributed to.
+type candidate struct {
+	preimage []byte
+	distance *big.Int
+}
+
+// expression returns the source expression of the variable kept at the slot,
+// e.g. balances[0xabc…], allowances[0xa…][0xb…] or orders[5].amount, or an
+// empty string if the slot can't be attributed to a state variable.
+//
+// Slots of state variables follow from the layout alone, while mapping
+// entries and the data of dynamic arrays live at hashes of their keys and
+// parent slots, which are resolved through the preimages the contract hashed
+// so far.
+func (d *storageDecoder) expression(slot *big.Int) string {
+	if d.layout == nil {
+		return ""
+	}
+
+	l := d.locate(slot, 0)
+	if l == nil {
+		return ""
+	}
+	return l.expression
+}
+
+// owners returns the state variables whose storage holds the slot, following
+// mapping entries and dynamic array data back to the variable they belong to.
+func (d *storageDecoder) owners(slot *big.Int) []*types.StorageVariable {
+	l := d.locate(slot, 0)
+	if l == nil {
+		return nil
+	}
+	return l.owners
+}
+
+// locate returns where the slot lies, or nil if it can't be attributed to a
+// state variable.
+func (d *storageDecoder) locate(slot *big.Int, depth int) *location {
+	if depth > maxDecodedDepth {
+		return nil
+	}
+
+	var owners []*types.StorageVariable
+	for _, variable := range d.layout.Storage {
+		if _, ok := d.occupies(variable, slot); ok {
+			owners = append(owners, variable)
+		}
+	}
+	if len(owners) > 0 {
+		expression, key, _ := d.within(d.layout.Storage, "", slot)
+		return &location{expression: expression, key: key, owners: owners}
+	}
+
+	// The closest hash below the slot isn't necessarily the one it belongs
+	// to, as the data of another variable may lie in between.
+	for _, c := range d.candidates(slot) {
+		if l := d.resolve(c, depth); l != nil {
+			return l
+		}
+	}
+
+	return nil
+}
+
+// resolve attributes a slot to the mapping entry or dynamic array data at the
+// hash of the candidate, provided the variable kept there reaches the slot.
+func (d *storageDecoder) resolve(c *candidate, depth int) *location {
+	// Both mapping entries and dynamic array data are keyed by the slot of
+	// their parent, hashed last.
+	prefix := c.preimage[:len(c.preimage)-32]
+	parentSlot := new(big.Int).SetBytes(c.preimage[len(c.preimage)-32:])
+
+	parent := d.locate(parentSlot, depth+1)
+	if parent == nil || parent.expression == "" {
+		return nil
+	}
+	t := d.layout.Types[parent.key]
+	if t == nil {
+		return nil
+	}
+
+	var expression, key string
+	switch t.Encoding {
+	case types.MappingEncoding:
+		mappingKey, ok := d.formatKey(t.Key, prefix)
+		if !ok {
+			return nil
+		}
+		expression, key = d.descend(parent.expression+"["+mappingKey+"]", t.Value, c.distance)
+	case types.DynamicArrayEncoding:
+		if len(prefix) != 0 {
+			return nil
+		}
+
+		// Lengths too large to be real leave the elements unbounded.
+		length := d.word(parentSlot)
+		if length.Sign() == 0 {
+			return nil
+		}
+		bound := int64(0)
+		if length.IsInt64() {
+			bound = length.Int64()
+		}
+		expression, key = d.element(parent.expression, t, c.distance, bound)
+	case types.BytesEncoding:
+		if len(prefix) != 0 || !d.bytesData(parentSlot, c.distance) {
+			return nil
+		}
+		expression, key = parent.expression, parent.key
+	}
+
+	if expression == "" {
+		return nil
+	}
+	return &location{expression: expression, key: key, owners: parent.owners}
+}
+
+// within finds the variables among the given ones which occupy the slot,
+// relative to their parent, and descends into them.
+func (d *storageDecoder) within(variables []*types.StorageVariable, prefix string, slot *big.Int) (string, string, bool) {
+	var matches []*types.StorageVariable
+	var start *big.Int
+	for _, variable := range variables {
+		if variableSlot, ok := d.occupies(variable, slot); ok {
+			matches = append(matches, variable)
+			start = variableSlot
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", "", false
+	case 1:
+		expression, key := d.descend(prefix+matches[0].Label, matches[0].Type, new(big.Int).Sub(slot, start))
+		return expression, key, expression != ""
+	}
+
+	// Packed variables share the slot.
+	var labels []string
+	for _, variable := range matches {
+		labels = append(labels, prefix+variable.Label)
+	}
+	return strings.Join(labels, ", "), "", true
+}
+
+// occupies reports whether the slot lies within the slots the variable takes
+// up relative to its parent, together with the first of them.
+func (d *storageDecoder) occupies(variable *types.StorageVariable, slot *big.Int) (*big.Int, bool) {
+	variableSlot, ok := new(big.Int).SetString(variable.Slot, 10)
+	if !ok || variableSlot.Cmp(slot) > 0 {
+		return nil, false
+	}
+
+	size := 32
+	if t := d.layout.Types[variable.Type]; t != nil {
+		if n, err := strconv.Atoi(t.NumberOfBytes); err == nil && n > size {
+			size = n
+		}
+	}
+
+	end := new(big.Int).Add(variableSlot, big.NewInt(int64((size+31)/32)))
+	return variableSlot, slot.Cmp(end) < 0
+}
+
+// descend walks from a variable into the struct member or static array
+// element at the given distance from its slot.
+func (d *storageDecoder) descend(expression, key string, distance *big.Int) (string, string) {
+	t := d.layout.Types[key]
+	if t == nil || t.Encoding != types.InplaceEncoding {
+		if distance.Sign() != 0 {
+			return "", ""
+		}
+		return expression, key
+	}
+
+	if t.Members != nil {
+		memberExpression, memberKey, ok := d.within(t.Members, expression+".", distance)
+		if !ok {
+			return "", ""
+		}
+		return memberExpression, memberKey
+	}
+
+	if t.Base != "" {
+		return d.element(expression, t, distance, int64(staticArrayLength(t.Label)))
+	}
+
+	if distance.Sign() != 0 {
+		return "", ""
+	}
+	return expression, key
+}
+
+// element returns the expression of the array element at the given distance
+// from the first slot of the array data. Small elements share slots, so the
+// range of elements is reported for them. A length of zero leaves the elements
+// unbounded.
+func (d *storageDecoder) element(expression string, t *types.StorageType, distance *big.Int, length int64) (string, string) {
+	base := d.layout.Types[t.Base]
+	if base == nil || !distance.IsInt64() {
+		return "", ""
+	}
+
+	elemSize, err := strconv.Atoi(base.NumberOfBytes)
+	if err != nil || elemSize <= 0 {
+		return "", ""
+	}
+
+	if elemSize <= 16 {
+		perSlot := int64(32 / elemSize)
+		first := distance.Int64() * perSlot
+		last := first + perSlot - 1
+		if length > 0 {
+			if first >= length {
+				return "", ""
+			}
+			if last >= length {
+				last = length - 1
+			}
+		}
+		return fmt.Sprintf("%s[%d..%d]", expression, first, last), ""
+	}
+
+	slots := int64((elemSize + 31) / 32)
+	index := distance.Int64() / slots
+	if length > 0 && index >= length {
+		return "", ""
+	}
+	return d.descend(fmt.Sprintf("%s[%d]", expression, index), t.Base, big.NewInt(distance.Int64()%slots))
+}
+
+// bytesData reports whether the slot at the given distance from the hash of
+// the slot of a byte array holds part of its data. Only long byte arrays keep
+// their data there, with twice their length plus one in their slot.
+func (d *storageDecoder) bytesData(slot *big.Int, distance *big.Int) bool {
+	word := d.word(slot)
+	if word.Bit(0) == 0 {
+		return false
+	}
+
+	length := new(big.Int).Rsh(word, 1)
+	slots := new(big.Int).Div(length.Add(length, big.NewInt(31)), big.NewInt(32))
+	return distance.Cmp(slots) < 0
+}
+
+// candidates returns the preimages of the hashes below the slot within
+// maxSlotDistance, closest first.
+func (d *storageDecoder) candidates(slot *big.Int) []*candidate {
+	var candidates []*candidate
+	for hash, preimage := range d.preimages {
+		if len(preimage) < 32 {
+			continue
+		}
+
+		distance := new(big.Int).Sub(slot, hash.Big())
+		if distance.Sign() < 0 || distance.Cmp(maxSlotDistance) >= 0 {
+			continue
+		}
+		candidates = append(candidates, &candidate{preimage: preimage, distance: distance})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].distance.Cmp(candidates[j].distance) < 0
+	})
+	return candidates
+}
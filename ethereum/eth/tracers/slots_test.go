@@ -0,0 +1,110 @@
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tenderly/tenderly-trace/ethereum/core/types"
+)
+
+// ledgerLayout lays out
+//
+//	uint256 total;
+//	mapping(address => uint256) balances;
+//	mapping(address => mapping(address => uint256)) allowances;
+//	Order[] orders;
+//
+// with struct Order { uint128 amount; bool filled; address maker; }.
+const ledgerLayout = `{
+	"storage": [
+		{"astId": 1, "contract": "Ledger.sol:Ledger", "label": "total", "offset": 0, "slot": "0", "type": "t_uint256"},
+		{"astId": 2, "contract": "Ledger.sol:Ledger", "label": "balances", "offset": 0, "slot": "1", "type": "t_mapping(t_address,t_uint256)"},
+		{"astId": 3, "contract": "Ledger.sol:Ledger", "label": "allowances", "offset": 0, "slot": "2", "type": "t_mapping(t_address,t_mapping(t_address,t_uint256))"},
+		{"astId": 4, "contract": "Ledger.sol:Ledger", "label": "orders", "offset": 0, "slot": "3", "type": "t_array(t_struct(Order)7_storage)dyn_storage"}
+	],
+	"types": {
+		"t_address": {"encoding": "inplace", "label": "address", "numberOfBytes": "20"},
+		"t_array(t_struct(Order)7_storage)dyn_storage": {"base": "t_struct(Order)7_storage", "encoding": "dynamic_array", "label": "struct Ledger.Order[]", "numberOfBytes": "32"},
+		"t_bool": {"encoding": "inplace", "label": "bool", "numberOfBytes": "1"},
+		"t_mapping(t_address,t_mapping(t_address,t_uint256))": {"encoding": "mapping", "key": "t_address", "label": "mapping(address => mapping(address => uint256))", "numberOfBytes": "32", "value": "t_mapping(t_address,t_uint256)"},
+		"t_mapping(t_address,t_uint256)": {"encoding": "mapping", "key": "t_address", "label": "mapping(address => uint256)", "numberOfBytes": "32", "value": "t_uint256"},
+		"t_struct(Order)7_storage": {"encoding": "inplace", "label": "struct Ledger.Order", "numberOfBytes": "64", "members": [
+			{"astId": 5, "contract": "Ledger.sol:Ledger", "label": "amount", "offset": 0, "slot": "0", "type": "t_uint128"},
+			{"astId": 6, "contract": "Ledger.sol:Ledger", "label": "filled", "offset": 16, "slot": "0", "type": "t_bool"},
+			{"astId": 7, "contract": "Ledger.sol:Ledger", "label": "maker", "offset": 0, "slot": "1", "type": "t_address"}
+		]},
+		"t_uint128": {"encoding": "inplace", "label": "uint128", "numberOfBytes": "16"},
+		"t_uint256": {"encoding": "inplace", "label": "uint256", "numberOfBytes": "32"}
+	}
+}`
+
+// Mapping entries, nested mapping entries and dynamic array elements resolve to
+// the expressions they are kept at, checked against the types and lengths of
+// their variables rather than attributed to the closest hash below them.
+func TestExpression(t *testing.T) {
+	var layout types.StorageLayout
+	if err := json.Unmarshal([]byte(ledgerLayout), &layout); err != nil {
+		t.Fatalf("failed parsing layout: %s", err)
+	}
+
+	owner, spender := common.HexToAddress("0xaa"), common.HexToAddress("0xbb")
+	hashed := func(key []byte, slot *big.Int) ([]byte, *big.Int) {
+		preimage := append(common.LeftPadBytes(key, 32), common.BigToHash(slot).Bytes()...)
+		return preimage, crypto.Keccak256Hash(preimage).Big()
+	}
+	offset := func(slot *big.Int, distance int64) *big.Int {
+		return new(big.Int).Add(slot, big.NewInt(distance))
+	}
+
+	balancePreimage, balance := hashed(owner.Bytes(), big.NewInt(1))
+	allowancesPreimage, allowances := hashed(owner.Bytes(), big.NewInt(2))
+	allowancePreimage, allowance := hashed(spender.Bytes(), allowances)
+	ordersPreimage, orders := hashed(nil, big.NewInt(3))
+
+	address := common.HexToAddress("0x01")
+	db := newMemoryState(nil)
+	db.SetState(address, common.BigToHash(big.NewInt(3)), common.BigToHash(big.NewInt(2)))
+
+	d := &storageDecoder{
+		db:      db,
+		address: address,
+		layout:  &layout,
+		preimages: map[common.Hash][]byte{
+			common.BigToHash(balance):    balancePreimage,
+			common.BigToHash(allowances): allowancesPreimage,
+			common.BigToHash(allowance):  allowancePreimage,
+			common.BigToHash(orders):     ordersPreimage[32:],
+			// A hash within the data of orders, closer to its slots than
+			// the one they belong to.
+			common.BigToHash(offset(orders, 1)): balancePreimage,
+		},
+	}
+
+	tests := []struct {
+		slot     *big.Int
+		expected string
+	}{
+		{big.NewInt(0), "total"},
+		{balance, "balances[" + owner.Hex() + "]"},
+		{offset(balance, 1), ""},
+		{allowance, "allowances[" + owner.Hex() + "][" + spender.Hex() + "]"},
+		{allowances, "allowances[" + owner.Hex() + "]"},
+		{orders, "orders[0].amount, orders[0].filled"},
+		{offset(orders, 3), "orders[1].maker"},
+		// Past the length of orders.
+		{offset(orders, 4), ""},
+		{crypto.Keccak256Hash([]byte("unknown")).Big(), ""},
+	}
+	for _, test := range tests {
+		if found := d.expression(test.slot); found != test.expected {
+			t.Errorf("slot %x: expected %q, got %q", test.slot, test.expected, found)
+		}
+	}
+
+	if owners := d.owners(offset(orders, 3)); len(owners) != 1 || owners[0].Label != "orders" {
+		t.Errorf("expected the slot owned by orders, got %v", owners)
+	}
+}
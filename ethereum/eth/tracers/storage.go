@@ -0,0 +1,354 @@
+package tracers
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tenderly/tenderly-trace/ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
+)
+
+// StorageVariable is a state variable decoded from storage.
+type StorageVariable struct {
+	Name     string      `json:"name"`
+	Contract string      `json:"contract,omitempty"`
+	Type     string      `json:"type"`
+	Slot     string      `json:"slot"`
+	Offset   int         `json:"offset"`
+	Value    interface{} `json:"value"`
+}
+
+// storageDecoder reads the state variables of a contract from storage
+// following its storage layout.
+type storageDecoder struct {
+	db          vm.StateDB
+	address     common.Address
+	layout      *types.StorageLayout
+	definitions types.Definitions
+	preimages   map[common.Hash][]byte
+}
+
+// newStorageDecoder returns a decoder of the state variables of the contract,
+// resolving mapping keys through the preimages hashed against its storage.
+// Delegated code is decoded against the storage of its caller.
+func newStorageDecoder(db vm.StateDB, contract *vm.Contract, preimages *Preimages) *storageDecoder {
+	return &storageDecoder{
+		db:          db,
+		address:     contract.Address(),
+		layout:      contract.StorageLayout,
+		definitions: contract.Definitions,
+		preimages:   preimages.Of(contract.Address()),
+	}
+}
+
+// variables decodes every state variable of the layout. Mappings hold the
+// entries whose keys were hashed during the trace so far.
+func (d *storageDecoder) variables() []*StorageVariable {
+	if d.layout == nil {
+		return nil
+	}
+
+	var variables []*StorageVariable
+	for _, variable := range d.layout.Storage {
+		if decoded := d.variable(variable); decoded != nil {
+			variables = append(variables, decoded)
+		}
+	}
+
+	return variables
+}
+
+// slotVariables decodes the state variables whose storage holds the slot,
+// which are the only ones a storage access to the slot may change.
+func (d *storageDecoder) slotVariables(slot *big.Int) []*StorageVariable {
+	if d.layout == nil {
+		return nil
+	}
+
+	var variables []*StorageVariable
+	for _, variable := range d.owners(slot) {
+		if decoded := d.variable(variable); decoded != nil {
+			variables = append(variables, decoded)
+		}
+	}
+
+	return variables
+}
+
+// variable decodes a single state variable of the layout.
+func (d *storageDecoder) variable(variable *types.StorageVariable) *StorageVariable {
+	slot, ok := new(big.Int).SetString(variable.Slot, 10)
+	if !ok {
+		return nil
+	}
+
+	label := variable.Type
+	if t := d.layout.Types[variable.Type]; t != nil {
+		label = t.Label
+	}
+
+	return &StorageVariable{
+		Name:     variable.Label,
+		Contract: variable.Contract,
+		Type:     label,
+		Slot:     hexutil.EncodeBig(slot),
+		Offset:   variable.Offset,
+		Value:    d.decode(variable.Type, slot, variable.Offset, 0),
+	}
+}
+
+// decodePointer returns the value a storage pointer of the given type points
+// to, or nil if the type isn't part of the storage layout. Layouts reported by
+// the compiler key their types differently from the layouts computed from the
+// AST, see types.SolType.LayoutKey.
+func (d *storageDecoder) decodePointer(t *types.SolType, slot *big.Int) interface{} {
+	if d.layout == nil {
+		return nil
+	}
+
+	for _, key := range []string{strings.TrimSuffix(t.Identifier, "_ptr"), t.LayoutKey()} {
+		if d.layout.Types[key] != nil {
+			return d.decode(key, slot, 0, 0)
+		}
+	}
+
+	return nil
+}
+
+// decode returns the value of the type kept at the given slot and offset.
+func (d *storageDecoder) decode(key string, slot *big.Int, offset int, depth int) interface{} {
+	t := d.layout.Types[key]
+	if t == nil || depth > maxDecodedDepth {
+		return nil
+	}
+
+	switch t.Encoding {
+	case types.MappingEncoding:
+		return d.decodeMapping(t, slot, depth)
+	case types.DynamicArrayEncoding:
+		length := d.word(slot)
+		if !length.IsInt64() || length.Int64() > maxDecodedLength {
+			return nil
+		}
+		return d.decodeArray(t, keccakSlot(slot), int(length.Int64()), depth)
+	case types.BytesEncoding:
+		data := d.decodeBytes(slot)
+		if data == nil {
+			return nil
+		}
+		if t.Label == "string" {
+			return string(data)
+		}
+		return hexutil.Encode(data)
+	}
+
+	if t.Members != nil {
+		value := structValue{}
+		for _, member := range t.Members {
+			memberSlot, ok := new(big.Int).SetString(member.Slot, 10)
+			if !ok {
+				continue
+			}
+
+			value = append(value, structField{
+				Name:  member.Label,
+				Value: d.decode(member.Type, memberSlot.Add(memberSlot, slot), member.Offset, depth+1),
+			})
+		}
+		return value
+	}
+
+	if t.Base != "" {
+		return d.decodeArray(t, slot, staticArrayLength(t.Label), depth)
+	}
+
+	size, err := strconv.Atoi(t.NumberOfBytes)
+	if err != nil || size <= 0 || offset+size > 32 {
+		return nil
+	}
+
+	word := common.BigToHash(d.word(slot)).Bytes()
+	data := word[32-offset-size : 32-offset]
+
+	valueType := d.labelType(t.Label)
+	if valueType.Kind == types.FixedBytesKind {
+		return hexutil.Encode(data)
+	}
+	return decodeValue(valueType, new(big.Int).SetBytes(data), d.definitions)
+}
+
+// decodeArray decodes the elements of an array starting at the given slot.
+// Elements which take up to half a slot are packed together.
+func (d *storageDecoder) decodeArray(t *types.StorageType, slot *big.Int, length int, depth int) interface{} {
+	base := d.layout.Types[t.Base]
+	if base == nil {
+		return nil
+	}
+
+	elemSize, err := strconv.Atoi(base.NumberOfBytes)
+	if err != nil || elemSize <= 0 {
+		return nil
+	}
+
+	values := make([]interface{}, 0, length)
+	for i := 0; i < length; i++ {
+		var elemSlot *big.Int
+		offset := 0
+		if elemSize <= 16 {
+			perSlot := 32 / elemSize
+			elemSlot = new(big.Int).Add(slot, big.NewInt(int64(i/perSlot)))
+			offset = i % perSlot * elemSize
+		} else {
+			slots := (elemSize + 31) / 32
+			elemSlot = new(big.Int).Add(slot, big.NewInt(int64(i*slots)))
+		}
+
+		values = append(values, d.decode(t.Base, elemSlot, offset, depth+1))
+	}
+
+	return values
+}
+
+// decodeMapping decodes the entries of a mapping whose keys were hashed. The
+// slot of an entry is the hash of its key, padded to a word unless it is a
+// byte array, followed by the slot of the mapping. Entries are sorted by key.
+func (d *storageDecoder) decodeMapping(t *types.StorageType, slot *big.Int, depth int) interface{} {
+	slotBytes := common.BigToHash(slot).Bytes()
+
+	entries := structValue{}
+	for hash, preimage := range d.preimages {
+		if len(preimage) < 32 || !bytes.Equal(preimage[len(preimage)-32:], slotBytes) {
+			continue
+		}
+
+		key, ok := d.formatKey(t.Key, preimage[:len(preimage)-32])
+		if !ok {
+			continue
+		}
+
+		entries = append(entries, structField{
+			Name:  key,
+			Value: d.decode(t.Value, hash.Big(), 0, depth+1),
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+
+	return entries
+}
+
+// formatKey decodes a mapping key from the part of a preimage preceding the
+// slot of the mapping.
+func (d *storageDecoder) formatKey(keyType string, key []byte) (string, bool) {
+	t := d.layout.Types[keyType]
+	if t == nil {
+		return "", false
+	}
+
+	if t.Encoding == types.BytesEncoding {
+		if t.Label == "string" {
+			return strconv.Quote(string(key)), true
+		}
+		return hexutil.Encode(key), true
+	}
+
+	if len(key) != 32 {
+		return "", false
+	}
+
+	value := d.labelType(t.Label)
+	if value.Kind == types.FixedBytesKind {
+		return hexutil.Encode(key[:value.Size]), true
+	}
+	return fmt.Sprint(decodeValue(value, new(big.Int).SetBytes(key), d.definitions)), true
+}
+
+// decodeBytes reads a byte array. Short ones are kept in the slot itself with
+// twice their length in the lowest byte, long ones keep twice their length
+// plus one in the slot and their data from the hash of the slot on.
+func (d *storageDecoder) decodeBytes(slot *big.Int) []byte {
+	word := common.BigToHash(d.word(slot)).Bytes()
+	if word[31]&1 == 0 {
+		length := int(word[31] / 2)
+		if length > 31 {
+			return nil
+		}
+		return word[:length]
+	}
+
+	length := new(big.Int).Rsh(new(big.Int).SetBytes(word), 1)
+	if !length.IsInt64() || length.Int64() > maxDecodedLength*32 {
+		return nil
+	}
+
+	data := make([]byte, 0, length.Int64())
+	dataSlot := keccakSlot(slot)
+	for int64(len(data)) < length.Int64() {
+		data = append(data, common.BigToHash(d.word(dataSlot)).Bytes()...)
+		dataSlot.Add(dataSlot, big.NewInt(1))
+	}
+
+	return data[:length.Int64()]
+}
+
+func (d *storageDecoder) word(slot *big.Int) *big.Int {
+	return d.db.GetState(d.address, common.BigToHash(slot)).Big()
+}
+
+// labelType resolves the value type named by a storage type label, e.g.
+// uint128, address payable or enum Token.Status.
+func (d *storageDecoder) labelType(label string) *types.SolType {
+	switch {
+	case strings.HasPrefix(label, "enum "):
+		name := label[strings.LastIndex(label, ".")+1:]
+		name = strings.TrimPrefix(name, "enum ")
+
+		t := &types.SolType{Kind: types.EnumKind, Name: name}
+		for id, definition := range d.definitions {
+			if definition.NodeType == "EnumDefinition" && definition.Name == name {
+				t.Id = id
+				break
+			}
+		}
+		return t
+	case strings.HasPrefix(label, "contract "):
+		return &types.SolType{Kind: types.ContractKind}
+	case strings.HasPrefix(label, "function"):
+		return &types.SolType{Kind: types.FunctionKind}
+	case label == "address payable":
+		return &types.SolType{Kind: types.AddressKind}
+	}
+
+	t, err := types.ParseTypeIdentifier("t_" + label)
+	if err != nil {
+		return &types.SolType{}
+	}
+	return t
+}
+
+// staticArrayLength returns the length of a static array from its label,
+// e.g. 3 for uint8[3].
+func staticArrayLength(label string) int {
+	open := strings.LastIndex(label, "[")
+	if open < 0 || !strings.HasSuffix(label, "]") {
+		return 0
+	}
+
+	length, err := strconv.Atoi(label[open+1 : len(label)-1])
+	if err != nil || length > maxDecodedLength {
+		return 0
+	}
+	return length
+}
+
+func keccakSlot(slot *big.Int) *big.Int {
+	return crypto.Keccak256Hash(common.BigToHash(slot).Bytes()).Big()
+}
@@ -0,0 +1,127 @@
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/tenderly/tenderly-trace/ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
+)
+
+// balancesLayout lays out
+//
+//	uint128 supply; uint128 cap;
+//	mapping(address => uint256) balances;
+//	address owner;
+const balancesLayout = `{
+	"storage": [
+		{"astId": 1, "contract": "Token.sol:Token", "label": "supply", "offset": 0, "slot": "0", "type": "t_uint128"},
+		{"astId": 2, "contract": "Token.sol:Token", "label": "cap", "offset": 16, "slot": "0", "type": "t_uint128"},
+		{"astId": 3, "contract": "Token.sol:Token", "label": "balances", "offset": 0, "slot": "1", "type": "t_mapping(t_address,t_uint256)"},
+		{"astId": 4, "contract": "Token.sol:Token", "label": "owner", "offset": 0, "slot": "2", "type": "t_address"}
+	],
+	"types": {
+		"t_address": {"encoding": "inplace", "label": "address", "numberOfBytes": "20"},
+		"t_mapping(t_address,t_uint256)": {"encoding": "mapping", "key": "t_address", "label": "mapping(address => uint256)", "numberOfBytes": "32", "value": "t_uint256"},
+		"t_uint128": {"encoding": "inplace", "label": "uint128", "numberOfBytes": "16"},
+		"t_uint256": {"encoding": "inplace", "label": "uint256", "numberOfBytes": "32"}
+	}
+}`
+
+// Only the state variables kept at an accessed slot are decoded again,
+// following mapping entries back to their mapping.
+func TestSlotVariables(t *testing.T) {
+	var layout types.StorageLayout
+	if err := json.Unmarshal([]byte(balancesLayout), &layout); err != nil {
+		t.Fatalf("failed parsing layout: %s", err)
+	}
+
+	holder := common.HexToAddress("0xbb")
+	preimage := append(holder.Hash().Bytes(), common.BigToHash(big.NewInt(1)).Bytes()...)
+	entry := crypto.Keccak256Hash(preimage)
+
+	d := &storageDecoder{
+		db:        newMemoryState(nil),
+		layout:    &layout,
+		preimages: map[common.Hash][]byte{entry: preimage},
+	}
+
+	names := func(slot *big.Int) string {
+		var names string
+		for _, variable := range d.slotVariables(slot) {
+			names += variable.Name + " "
+		}
+		return names
+	}
+
+	tests := []struct {
+		slot     *big.Int
+		expected string
+	}{
+		{big.NewInt(0), "supply cap "},
+		{big.NewInt(2), "owner "},
+		{entry.Big(), "balances "},
+		{big.NewInt(3), ""},
+		{crypto.Keccak256Hash([]byte("unknown")).Big(), ""},
+	}
+	for _, test := range tests {
+		if found := names(test.slot); found != test.expected {
+			t.Errorf("slot %x: expected %q, got %q", test.slot, test.expected, found)
+		}
+	}
+}
+
+// Mappings only hold the entries whose keys the contract itself hashed, not
+// the ones other contracts hashed with the same slot against their storage.
+func TestPreimagesPerAccount(t *testing.T) {
+	var layout types.StorageLayout
+	if err := json.Unmarshal([]byte(balancesLayout), &layout); err != nil {
+		t.Fatalf("failed parsing layout: %s", err)
+	}
+
+	tracer, err := New("{step: function() {}, fault: function() {}, result: function() { return null; }}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := vm.NewEVM(vm.Context{BlockNumber: big.NewInt(1)}, nil, params.TestChainConfig, vm.Config{Debug: true, Tracer: tracer})
+
+	// Both contracts hash their holder with the slot of balances.
+	token, other := common.HexToAddress("0xaa"), common.HexToAddress("0xcc")
+	holders := map[common.Address]byte{token: 0xbb, other: 0xdd}
+	db := newMemoryState(nil)
+	for address, holder := range holders {
+		contract := vm.NewContract(vm.AccountRef(address), vm.AccountRef(address), big.NewInt(0), 10000)
+		contract.Code = []byte{
+			byte(vm.PUSH1), holder, byte(vm.PUSH1), 0x0, byte(vm.MSTORE),
+			byte(vm.PUSH1), 0x1, byte(vm.PUSH1), 0x20, byte(vm.MSTORE),
+			byte(vm.PUSH1), 0x40, byte(vm.PUSH1), 0x0, byte(vm.SHA3),
+			byte(vm.STOP),
+		}
+		if _, err := env.Interpreter().Run(contract, []byte{}); err != nil {
+			t.Fatal(err)
+		}
+
+		preimage := append(common.BytesToAddress([]byte{holder}).Hash().Bytes(), common.BigToHash(big.NewInt(1)).Bytes()...)
+		db.SetState(token, crypto.Keccak256Hash(preimage), common.BigToHash(big.NewInt(int64(holder))))
+	}
+
+	contract := vm.NewContract(vm.AccountRef(token), vm.AccountRef(token), big.NewInt(0), 0)
+	contract.StorageLayout = &layout
+
+	variables := DecodeStateVariables(db, contract, tracer.preimages)
+	if len(variables) != 4 || variables[2].Name != "balances" {
+		t.Fatalf("expected the state variables of the layout decoded, got %d", len(variables))
+	}
+	data, err := json.Marshal(variables[2].Value)
+	if err != nil {
+		t.Fatalf("failed encoding: %s", err)
+	}
+	expected := `{"` + common.HexToAddress("0xbb").Hex() + `":"187"}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+}
@@ -0,0 +1,120 @@
+package tracers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
+)
+
+// StructLoggerName is the name the opcode level struct logger is registered under.
+const StructLoggerName = "structLogger"
+
+func init() {
+	Register(StructLoggerName, newStructLogger)
+}
+
+// ExecutionResult groups all structured logs emitted by the EVM while replaying
+// a transaction, in the same format debug_traceTransaction reports them.
+type ExecutionResult struct {
+	Gas         uint64         `json:"gas"`
+	Failed      bool           `json:"failed"`
+	ReturnValue string         `json:"returnValue"`
+	StructLogs  []StructLogRes `json:"structLogs"`
+}
+
+// StructLogRes stores a structured log emitted by the EVM while replaying a
+// transaction.
+type StructLogRes struct {
+	Pc      uint64             `json:"pc"`
+	Op      string             `json:"op"`
+	Gas     uint64             `json:"gas"`
+	GasCost uint64             `json:"gasCost"`
+	Depth   int                `json:"depth"`
+	Error   string             `json:"error,omitempty"`
+	Stack   *[]string          `json:"stack,omitempty"`
+	Memory  *[]string          `json:"memory,omitempty"`
+	Storage *map[string]string `json:"storage,omitempty"`
+}
+
+// structLogger exposes vm.StructLogger as a ResultTracer. The config is
+// unmarshalled into vm.LogConfig, so the usual disableMemory, disableStack,
+// disableStorage and limit options apply.
+type structLogger struct {
+	*vm.StructLogger
+
+	// gasUsed is the gas used by the transaction, once it was reported.
+	gasUsed *uint64
+}
+
+func newStructLogger(config json.RawMessage) (ResultTracer, error) {
+	var cfg vm.LogConfig
+	if len(config) > 0 {
+		if err := json.Unmarshal(config, &cfg); err != nil {
+			return nil, fmt.Errorf("failed parsing struct logger config: %s", err)
+		}
+	}
+
+	return &structLogger{
+		StructLogger: vm.NewStructLogger(&cfg),
+	}, nil
+}
+
+// SetGasUsed reports the gas used by the transaction, which the result reports
+// in place of the gas used by its call, as debug_traceTransaction does.
+func (l *structLogger) SetGasUsed(gas uint64) {
+	l.gasUsed = &gas
+}
+
+// GetResult returns the captured logs formatted as debug_traceTransaction does.
+func (l *structLogger) GetResult() (json.RawMessage, error) {
+	gas := l.GasUsed()
+	if l.gasUsed != nil {
+		gas = *l.gasUsed
+	}
+
+	return json.Marshal(&ExecutionResult{
+		Gas:         gas,
+		Failed:      l.Error() != nil,
+		ReturnValue: fmt.Sprintf("%x", l.Output()),
+		StructLogs:  FormatLogs(l.StructLogs()),
+	})
+}
+
+// FormatLogs formats EVM returned structured logs for json output.
+func FormatLogs(logs []vm.StructLog) []StructLogRes {
+	formatted := make([]StructLogRes, len(logs))
+	for index, trace := range logs {
+		formatted[index] = StructLogRes{
+			Pc:      trace.Pc,
+			Op:      trace.Op.String(),
+			Gas:     trace.Gas,
+			GasCost: trace.GasCost,
+			Depth:   trace.Depth,
+			Error:   trace.ErrorString(),
+		}
+		if trace.Stack != nil {
+			stack := make([]string, len(trace.Stack))
+			for i, stackValue := range trace.Stack {
+				stack[i] = fmt.Sprintf("%x", math.PaddedBigBytes(stackValue, 32))
+			}
+			formatted[index].Stack = &stack
+		}
+		if trace.Memory != nil {
+			memory := make([]string, 0, (len(trace.Memory)+31)/32)
+			for i := 0; i+32 <= len(trace.Memory); i += 32 {
+				memory = append(memory, fmt.Sprintf("%x", trace.Memory[i:i+32]))
+			}
+			formatted[index].Memory = &memory
+		}
+		if trace.Storage != nil {
+			storage := make(map[string]string)
+			for i, storageValue := range trace.Storage {
+				storage[fmt.Sprintf("%x", i)] = fmt.Sprintf("%x", storageValue)
+			}
+			formatted[index].Storage = &storage
+		}
+	}
+	return formatted
+}
@@ -0,0 +1,40 @@
+package tracers
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// The struct logger reports the gas used by the transaction once it is known,
+// like debug_traceTransaction, rather than the gas used by its call.
+func TestStructLoggerGas(t *testing.T) {
+	tracer, err := NewTracer(StructLoggerName, nil)
+	if err != nil {
+		t.Fatalf("failed creating tracer: %s", err)
+	}
+	tracer.CaptureEnd(nil, 100, 0, nil)
+
+	gas := func() uint64 {
+		data, err := tracer.GetResult()
+		if err != nil {
+			t.Fatalf("failed getting result: %s", err)
+		}
+
+		var result ExecutionResult
+		err = json.Unmarshal(data, &result)
+		if err != nil {
+			t.Fatalf("failed decoding result: %s", err)
+		}
+
+		return result.Gas
+	}
+
+	if used := gas(); used != 100 {
+		t.Errorf("expected the gas used by the call, got %d", used)
+	}
+
+	tracer.(GasReporter).SetGasUsed(21100)
+	if used := gas(); used != 21100 {
+		t.Errorf("expected the gas used by the transaction, got %d", used)
+	}
+}
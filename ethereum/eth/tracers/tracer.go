@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/tenderly/tenderly-trace/ethereum/core/types"
 	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
 	"math/big"
 	"sync/atomic"
@@ -300,6 +301,7 @@ type Tracer struct {
 	memoryWrapper   *memoryWrapper   // Wrapper around the VM memory
 	contractWrapper *contractWrapper // Wrapper around the contract object
 	dbWrapper       *dbWrapper       // Wrapper around the VM environment
+	preimages       *Preimages       // Preimages hashed so far, per account
 
 	pcValue    *uint   // Swappable pc value wrapped by a log accessor
 	gasValue   *uint   // Swappable gas value wrapped by a log accessor
@@ -318,6 +320,13 @@ type Tracer struct {
 // which must evaluate to an expression returning an object with 'step', 'fault'
 // and 'result' functions.
 func New(code string) (*Tracer, error) {
+	return NewWithConfig(code, nil)
+}
+
+// NewWithConfig instantiates a new tracer instance the same way New does, and
+// afterwards passes the given JSON config to the optional 'setup' function of
+// the tracer object.
+func NewWithConfig(code string, config json.RawMessage) (*Tracer, error) {
 	// Resolve any tracers by name and assemble the tracer object
 	if tracer, ok := tracer(code); ok {
 		code = tracer
@@ -330,6 +339,7 @@ func New(code string) (*Tracer, error) {
 		memoryWrapper:   new(memoryWrapper),
 		contractWrapper: new(contractWrapper),
 		dbWrapper:       new(dbWrapper),
+		preimages:       NewPreimages(),
 		pcValue:         new(uint),
 		gasValue:        new(uint),
 		costValue:       new(uint),
@@ -475,6 +485,153 @@ func New(code string) (*Tracer, error) {
 	})
 	tracer.vm.PutPropString(logObject, "getOpCodeStackChange")
 
+	// Generate the `decode` method which takes a type identifier and the stack
+	// position of a variable and returns its value as a JSON string
+	tracer.vm.PushGoFunction(func(ctx *duktape.Context) int {
+		identifier := ctx.GetString(-2)
+		position := ctx.GetInt(-1)
+		ctx.Pop2()
+
+		var value interface{}
+		if t, err := types.ParseTypeIdentifier(identifier); err == nil {
+			decoder := &variableDecoder{
+				stack:    tracer.stackWrapper.stack,
+				memory:   tracer.memoryWrapper.memory,
+				contract: tracer.contractWrapper.contract,
+				storage:  tracer.storageDecoder(),
+			}
+			value = decoder.decode(t, position)
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			encoded = []byte("null")
+		}
+		ctx.PushString(string(encoded))
+		return 1
+	})
+	tracer.vm.PutPropString(logObject, "decode")
+
+	// Generate the `getStackSize` method which takes a type identifier and
+	// returns the number of stack slots a variable of that type takes
+	tracer.vm.PushGoFunction(func(ctx *duktape.Context) int {
+		identifier := ctx.GetString(-1)
+		ctx.Pop()
+
+		size := 1
+		if t, err := types.ParseTypeIdentifier(identifier); err == nil {
+			size = stackSize(t)
+		}
+		ctx.PushInt(size)
+		return 1
+	})
+	tracer.vm.PutPropString(logObject, "getStackSize")
+
+	// Generate the `getStorageVariables` method which returns the state
+	// variables of the current contract decoded from storage as a JSON string
+	tracer.vm.PushGoFunction(func(ctx *duktape.Context) int {
+		encoded, err := json.Marshal(tracer.storageDecoder().variables())
+		if err != nil {
+			encoded = []byte("null")
+		}
+		ctx.PushString(string(encoded))
+		return 1
+	})
+	tracer.vm.PutPropString(logObject, "getStorageVariables")
+
+	// Generate the `getSlotVariables` method which takes a storage slot and
+	// returns the state variables of the current contract kept there decoded
+	// from storage as a JSON string
+	tracer.vm.PushGoFunction(func(ctx *duktape.Context) int {
+		slot := common.BytesToHash(popSlice(ctx))
+		encoded, err := json.Marshal(tracer.storageDecoder().slotVariables(slot.Big()))
+		if err != nil {
+			encoded = []byte("null")
+		}
+		ctx.PushString(string(encoded))
+		return 1
+	})
+	tracer.vm.PutPropString(logObject, "getSlotVariables")
+
+	// Generate the `getSlotExpression` method which takes a storage slot and
+	// returns the source expression of the variable kept there, if known
+	tracer.vm.PushGoFunction(func(ctx *duktape.Context) int {
+		slot := common.BytesToHash(popSlice(ctx))
+		ctx.PushString(tracer.storageDecoder().expression(slot.Big()))
+		return 1
+	})
+	tracer.vm.PutPropString(logObject, "getSlotExpression")
+
+	// Generate the `decodeRevert` method which takes the data the current
+	// contract reverts with and returns the decoded reason as a JSON string
+	tracer.vm.PushGoFunction(func(ctx *duktape.Context) int {
+		data := popSlice(ctx)
+
+		encoded, err := json.Marshal(decodeRevert(data, tracer.contractWrapper.contract.Abi))
+		if err != nil {
+			encoded = []byte("null")
+		}
+		ctx.PushString(string(encoded))
+		return 1
+	})
+	tracer.vm.PutPropString(logObject, "decodeRevert")
+
+	// Generate the `decodeCallInput` method which takes the address of a
+	// callee and the calldata of the call and returns the called function with
+	// its decoded inputs as a JSON string, using the ABI of the callee
+	tracer.vm.PushGoFunction(func(ctx *duktape.Context) int {
+		input := popSlice(ctx)
+		address := common.BytesToAddress(popSlice(ctx))
+
+		encoded, err := json.Marshal(decodeCallInput(tracer.dbWrapper.db.GetAbi(address), input))
+		if err != nil {
+			encoded = []byte("null")
+		}
+		ctx.PushString(string(encoded))
+		return 1
+	})
+	tracer.vm.PutPropString(logObject, "decodeCallInput")
+
+	// Generate the `decodeCallOutput` method which takes the address of a
+	// callee, the calldata of the call and the data it returned with and
+	// returns the decoded outputs as a JSON string
+	tracer.vm.PushGoFunction(func(ctx *duktape.Context) int {
+		output := popSlice(ctx)
+		input := popSlice(ctx)
+		address := common.BytesToAddress(popSlice(ctx))
+
+		encoded, err := json.Marshal(decodeCallOutput(tracer.dbWrapper.db.GetAbi(address), input, output))
+		if err != nil {
+			encoded = []byte("null")
+		}
+		ctx.PushString(string(encoded))
+		return 1
+	})
+	tracer.vm.PutPropString(logObject, "decodeCallOutput")
+
+	// Generate the `decodeLog` method which takes the topics of a log emitted
+	// by the current code as a JSON array and its data and returns the
+	// decoded event as a JSON string
+	tracer.vm.PushGoFunction(func(ctx *duktape.Context) int {
+		data := popSlice(ctx)
+		var topics []common.Hash
+		topicsErr := json.Unmarshal([]byte(ctx.GetString(-1)), &topics)
+		ctx.Pop()
+
+		var decoded *decodedLog
+		if topicsErr == nil {
+			decoded = decodeLog(tracer.contractWrapper.contract.Abi, topics, data)
+		}
+
+		encoded, err := json.Marshal(decoded)
+		if err != nil {
+			encoded = []byte("null")
+		}
+		ctx.PushString(string(encoded))
+		return 1
+	})
+	tracer.vm.PutPropString(logObject, "decodeLog")
+
 	tracer.vm.PushGoFunction(func(ctx *duktape.Context) int { ctx.PushUint(*tracer.gasValue); return 1 })
 	tracer.vm.PutPropString(logObject, "getGas")
 
@@ -499,9 +656,39 @@ func New(code string) (*Tracer, error) {
 	tracer.dbWrapper.pushObject(tracer.vm)
 	tracer.vm.PutPropString(tracer.stateObject, "db")
 
+	if err := tracer.setup(config); err != nil {
+		return nil, wrapError("setup", err)
+	}
+
 	return tracer, nil
 }
 
+// setup invokes the optional 'setup' function of the tracer object with the
+// decoded JSON config.
+func (jst *Tracer) setup(config json.RawMessage) error {
+	hasSetup := jst.vm.GetPropString(jst.tracerObject, "setup")
+	jst.vm.Pop()
+
+	if !hasSetup {
+		return nil
+	}
+	if len(config) == 0 {
+		config = json.RawMessage("{}")
+	}
+
+	jst.vm.PushString("setup")
+	jst.vm.PushString(string(config))
+	jst.vm.JsonDecode(-1)
+
+	code := jst.vm.PcallProp(jst.tracerObject, 1)
+	defer jst.vm.Pop()
+
+	if code != 0 {
+		return errors.New(jst.vm.SafeToString(-1))
+	}
+	return nil
+}
+
 // Stop terminates execution of the tracer at the first opportune moment.
 func (jst *Tracer) Stop(err error) {
 	jst.reason = err
@@ -537,7 +724,7 @@ func wrapError(context string, err error) error {
 }
 
 // CaptureStart implements the Tracer interface to initialize the tracing operation.
-func (jst *Tracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+func (jst *Tracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
 	jst.ctx["type"] = "CALL"
 	if create {
 		jst.ctx["type"] = "CREATE"
@@ -573,6 +760,7 @@ func (jst *Tracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost
 		jst.memoryWrapper.memory = memory
 		jst.contractWrapper.contract = contract
 		jst.dbWrapper.db = env.StateDB
+		jst.preimages.Record(op, memory, stack, contract)
 
 		*jst.pcValue = uint(pc)
 		*jst.gasValue = uint(gas)
@@ -592,6 +780,12 @@ func (jst *Tracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost
 	return nil
 }
 
+// storageDecoder returns a decoder of the state variables of the current
+// contract.
+func (jst *Tracer) storageDecoder() *storageDecoder {
+	return newStorageDecoder(jst.dbWrapper.db, jst.contractWrapper.contract, jst.preimages)
+}
+
 // CaptureFault implements the Tracer interface to trace an execution fault
 // while running an opcode.
 func (jst *Tracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
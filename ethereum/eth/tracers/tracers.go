@@ -18,14 +18,51 @@
 package tracers
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
 	"unicode"
 
+	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
 	"github.com/tenderly/tenderly-trace/ethereum/eth/tracers/internal/tracers"
+	"github.com/tenderly/tenderly-trace/ethereum/signer/accounts/signer/fourbyte"
 )
 
-// all contains all the built in JavaScript tracers by name.
-var all = make(map[string]string)
+// ResultTracer is a vm.Tracer which is able to report the outcome of the
+// tracing in JSON form once the execution is finished.
+type ResultTracer interface {
+	vm.Tracer
+
+	GetResult() (json.RawMessage, error)
+}
+
+// GasReporter is a tracer whose result includes the gas used by the whole
+// transaction, intrinsic gas and refunds included, which only the state
+// transition knows. It is told the gas once the transaction is applied.
+type GasReporter interface {
+	SetGasUsed(gas uint64)
+}
+
+// Factory creates a new native tracer configured with the given JSON config.
+// The config is nil if the caller didn't provide one.
+type Factory func(config json.RawMessage) (ResultTracer, error)
+
+var (
+	lock sync.RWMutex
+
+	// all contains all the built in and loaded JavaScript tracers by name.
+	all = make(map[string]string)
+
+	// native contains all the registered native tracer factories by name.
+	native = make(map[string]Factory)
+
+	// signatures decodes calls and logs of contracts without an ABI.
+	signatures *fourbyte.Database
+)
 
 // camel converts a snake cased input string into a camel cased output.
 func camel(str string) string {
@@ -46,8 +83,120 @@ func init() {
 
 // tracer retrieves a specific JavaScript tracer by name.
 func tracer(name string) (string, bool) {
+	lock.RLock()
+	defer lock.RUnlock()
+
 	if tracer, ok := all[name]; ok {
 		return tracer, true
 	}
 	return "", false
 }
+
+// Register makes a native tracer available under the given name. Registering
+// a name twice replaces the previous factory.
+func Register(name string, factory Factory) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	native[name] = factory
+}
+
+// LoadDir loads every JavaScript tracer found in the given directory. Tracers
+// are named the same way as the built in ones, so my_tracer.js becomes
+// available as myTracer, replacing any tracer previously known by that name.
+func LoadDir(dir string) error {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("failed listing tracers: %s", err)
+	}
+
+	loaded := make(map[string]string)
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".js") {
+			continue
+		}
+
+		code, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return fmt.Errorf("failed reading tracer %s: %s", file.Name(), err)
+		}
+
+		loaded[camel(strings.TrimSuffix(file.Name(), ".js"))] = string(code)
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	for name, code := range loaded {
+		all[name] = code
+	}
+
+	return nil
+}
+
+// LoadSignatures seeds the signature database the tracers fall back to for
+// calls and logs the ABI of the contract doesn't know from the given file, see fourbyte.Database.Load.
+func LoadSignatures(path string) error {
+	db, err := fourbyte.NewFromFile(path)
+	if err != nil {
+		return err
+	}
+
+	SetSignatures(db)
+	return nil
+}
+
+// SetSignatures replaces the signature database the tracers fall back to for
+// calls and logs the ABI of the contract doesn't know.
+func SetSignatures(db *fourbyte.Database) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	signatures = db
+}
+
+func signatureDatabase() *fourbyte.Database {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	return signatures
+}
+
+// Names returns the names of all the known native and JavaScript tracers.
+func Names() []string {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	var names []string
+	for name := range native {
+		names = append(names, name)
+	}
+	for name := range all {
+		if _, ok := native[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// NewTracer resolves the tracer by name and configures it with the given JSON
+// config. Native tracers take precedence over JavaScript ones, and a name
+// that matches neither is evaluated as JavaScript tracer code.
+func NewTracer(name string, config json.RawMessage) (ResultTracer, error) {
+	lock.RLock()
+	factory, ok := native[name]
+	lock.RUnlock()
+
+	if ok {
+		return factory(config)
+	}
+
+	tracer, err := NewWithConfig(name, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return tracer, nil
+}
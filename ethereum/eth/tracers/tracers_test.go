@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"math/big"
+	"os"
 	"path/filepath"
 	"reflect"
 	"strings"
@@ -28,12 +29,14 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
-	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/core/vm"
-	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
-	"github.com/ethereum/go-ethereum/tests"
+	"github.com/tenderly/tenderly-trace/ethereum/core"
+	types2 "github.com/tenderly/tenderly-trace/ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
+	"github.com/tenderly/tenderly-trace/ethereum/signer/accounts/abi"
 )
 
 // To generate a new callTracer test, copy paste the makeTest method below into
@@ -108,12 +111,26 @@ type callContext struct {
 	Miner      common.Address        `json:"miner"`
 }
 
+// genesisAccount is an account of the prestate the call tracer tests run on.
+type genesisAccount struct {
+	Code    hexutil.Bytes               `json:"code"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+	Balance *math.HexOrDecimal256       `json:"balance"`
+	Nonce   math.HexOrDecimal64         `json:"nonce"`
+}
+
+// genesis is the chain configuration and prestate of a call tracer test.
+type genesis struct {
+	Config *params.ChainConfig                `json:"config"`
+	Alloc  map[common.Address]*genesisAccount `json:"alloc"`
+}
+
 // callTracerTest defines a single test to check the call tracer against.
 type callTracerTest struct {
-	Genesis *core.Genesis `json:"genesis"`
-	Context *callContext  `json:"context"`
-	Input   string        `json:"input"`
-	Result  *callTrace    `json:"result"`
+	Genesis *genesis     `json:"genesis"`
+	Context *callContext `json:"context"`
+	Input   string       `json:"input"`
+	Result  *callTrace   `json:"result"`
 }
 
 // Iterates over all the input-output datasets in the tracer test harness and
@@ -159,10 +176,10 @@ func TestCallTracer(t *testing.T) {
 				GasLimit:    uint64(test.Context.GasLimit),
 				GasPrice:    tx.GasPrice(),
 			}
-			statedb := tests.MakePreState(ethdb.NewMemDatabase(), test.Genesis.Alloc)
+			statedb := newMemoryState(test.Genesis.Alloc)
 
 			// Create the tracer, the EVM environment and run it
-			tracer, err := New("callTracer")
+			tracer, err := New("callTracerOriginal")
 			if err != nil {
 				t.Fatalf("failed to create call tracer: %v", err)
 			}
@@ -191,3 +208,215 @@ func TestCallTracer(t *testing.T) {
 		})
 	}
 }
+
+// constantTracer is JavaScript tracer code whose result is the given string.
+func constantTracer(result string) string {
+	return `{step: function() {}, fault: function() {}, result: function() { return "` + result + `"; }}`
+}
+
+func TestRegistry(t *testing.T) {
+	Register("testNative", func(config json.RawMessage) (ResultTracer, error) {
+		return NewWithConfig(constantTracer("native"), config)
+	})
+
+	dir, err := ioutil.TempDir("", "tracers")
+	if err != nil {
+		t.Fatalf("failed to create tracer directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	err = ioutil.WriteFile(filepath.Join(dir, "loaded_tracer.js"), []byte(constantTracer("loaded")), 0644)
+	if err != nil {
+		t.Fatalf("failed to write tracer: %v", err)
+	}
+	if err := LoadDir(dir); err != nil {
+		t.Fatalf("failed to load tracers: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		result string
+	}{
+		{"testNative", `"native"`},
+		// Files are named like the built in tracers.
+		{"loadedTracer", `"loaded"`},
+		// Anything else is tracer code.
+		{constantTracer("code"), `"code"`},
+	}
+	for _, test := range tests {
+		tracer, err := NewTracer(test.name, nil)
+		if err != nil {
+			t.Fatalf("failed to create tracer %s: %v", test.name, err)
+		}
+		result, err := tracer.GetResult()
+		if err != nil {
+			t.Fatalf("failed to get result of tracer %s: %v", test.name, err)
+		}
+		if string(result) != test.result {
+			t.Errorf("tracer %s: expected result %s, got %s", test.name, test.result, result)
+		}
+	}
+
+	names := strings.Join(Names(), ",")
+	for _, name := range []string{"testNative", "loadedTracer", "callTracerOriginal"} {
+		if !strings.Contains(","+names+",", ","+name+",") {
+			t.Errorf("expected tracer %s among %s", name, names)
+		}
+	}
+
+	if _, err := NewTracer("unknownTracer", nil); err == nil {
+		t.Errorf("expected an error for an unknown tracer name")
+	}
+}
+
+// memoryState is a vm.StateDB held in memory, seeded with the prestate of a
+// test instead of being read from a node.
+type memoryState struct {
+	accounts  map[common.Address]*memoryAccount
+	refund    uint64
+	logs      []*types.Log
+	snapshots []memorySnapshot
+}
+
+type memoryAccount struct {
+	balance  *big.Int
+	nonce    uint64
+	code     []byte
+	storage  map[common.Hash]common.Hash
+	suicided bool
+}
+
+type memorySnapshot struct {
+	accounts map[common.Address]*memoryAccount
+	refund   uint64
+	logs     int
+}
+
+func newMemoryState(alloc map[common.Address]*genesisAccount) *memoryState {
+	db := &memoryState{accounts: make(map[common.Address]*memoryAccount)}
+	for addr, account := range alloc {
+		storage := make(map[common.Hash]common.Hash)
+		for key, value := range account.Storage {
+			storage[key] = value
+		}
+
+		balance := new(big.Int)
+		if account.Balance != nil {
+			balance.Set((*big.Int)(account.Balance))
+		}
+
+		db.accounts[addr] = &memoryAccount{
+			balance: balance,
+			nonce:   uint64(account.Nonce),
+			code:    account.Code,
+			storage: storage,
+		}
+	}
+	return db
+}
+
+func (db *memoryState) account(addr common.Address) *memoryAccount {
+	account, ok := db.accounts[addr]
+	if !ok {
+		account = &memoryAccount{balance: new(big.Int), storage: make(map[common.Hash]common.Hash)}
+		db.accounts[addr] = account
+	}
+	return account
+}
+
+func (db *memoryState) CreateAccount(addr common.Address) {
+	balance := new(big.Int).Set(db.account(addr).balance)
+	db.accounts[addr] = &memoryAccount{balance: balance, storage: make(map[common.Hash]common.Hash)}
+}
+
+func (db *memoryState) SubBalance(addr common.Address, amount *big.Int) {
+	account := db.account(addr)
+	account.balance = new(big.Int).Sub(account.balance, amount)
+}
+
+func (db *memoryState) AddBalance(addr common.Address, amount *big.Int) {
+	account := db.account(addr)
+	account.balance = new(big.Int).Add(account.balance, amount)
+}
+
+func (db *memoryState) GetBalance(addr common.Address) *big.Int { return db.account(addr).balance }
+func (db *memoryState) GetNonce(addr common.Address) uint64     { return db.account(addr).nonce }
+func (db *memoryState) SetNonce(addr common.Address, nonce uint64) {
+	db.account(addr).nonce = nonce
+}
+
+func (db *memoryState) GetCodeAst(common.Address) types2.Ast                  { return types2.Ast{} }
+func (db *memoryState) GetStateVariables(common.Address) []*types2.Node       { return nil }
+func (db *memoryState) GetDefinitions(common.Address) types2.Definitions      { return nil }
+func (db *memoryState) GetStorageLayout(common.Address) *types2.StorageLayout { return nil }
+func (db *memoryState) GetAbi(common.Address) *abi.ABI                        { return nil }
+func (db *memoryState) GetCode(addr common.Address) []byte                    { return db.account(addr).code }
+func (db *memoryState) GetCodeSize(addr common.Address) int                   { return len(db.account(addr).code) }
+func (db *memoryState) SetCode(addr common.Address, code []byte)              { db.account(addr).code = code }
+func (db *memoryState) GetCodeHash(addr common.Address) common.Hash {
+	return crypto.Keccak256Hash(db.account(addr).code)
+}
+
+func (db *memoryState) AddRefund(gas uint64) { db.refund += gas }
+func (db *memoryState) GetRefund() uint64    { return db.refund }
+
+func (db *memoryState) GetState(addr common.Address, key common.Hash) common.Hash {
+	return db.account(addr).storage[key]
+}
+
+func (db *memoryState) SetState(addr common.Address, key, value common.Hash) {
+	db.account(addr).storage[key] = value
+}
+
+func (db *memoryState) Suicide(addr common.Address) bool {
+	account := db.account(addr)
+	account.suicided = true
+	account.balance = new(big.Int)
+	return true
+}
+
+func (db *memoryState) HasSuicided(addr common.Address) bool { return db.account(addr).suicided }
+
+func (db *memoryState) Exist(addr common.Address) bool {
+	_, ok := db.accounts[addr]
+	return ok
+}
+
+func (db *memoryState) Empty(addr common.Address) bool {
+	account, ok := db.accounts[addr]
+	return !ok || (account.balance.Sign() == 0 && account.nonce == 0 && len(account.code) == 0)
+}
+
+func (db *memoryState) Snapshot() int {
+	accounts := make(map[common.Address]*memoryAccount, len(db.accounts))
+	for addr, account := range db.accounts {
+		storage := make(map[common.Hash]common.Hash, len(account.storage))
+		for key, value := range account.storage {
+			storage[key] = value
+		}
+		copied := *account
+		copied.storage = storage
+		accounts[addr] = &copied
+	}
+
+	db.snapshots = append(db.snapshots, memorySnapshot{accounts: accounts, refund: db.refund, logs: len(db.logs)})
+	return len(db.snapshots) - 1
+}
+
+func (db *memoryState) RevertToSnapshot(revid int) {
+	snapshot := db.snapshots[revid]
+	db.accounts = snapshot.accounts
+	db.refund = snapshot.refund
+	db.logs = db.logs[:snapshot.logs]
+	db.snapshots = db.snapshots[:revid]
+}
+
+func (db *memoryState) AddLog(log *types.Log)           { db.logs = append(db.logs, log) }
+func (db *memoryState) AddPreimage(common.Hash, []byte) {}
+func (db *memoryState) ForEachStorage(addr common.Address, cb func(common.Hash, common.Hash) bool) {
+	for key, value := range db.account(addr).storage {
+		if !cb(key, value) {
+			return
+		}
+	}
+}
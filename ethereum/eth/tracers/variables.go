@@ -0,0 +1,44 @@
+package tracers
+
+import (
+	"github.com/tenderly/tenderly-trace/ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
+)
+
+// DecodeVariable returns the value of the variable with the given type
+// identifier whose topmost stack slot is at the given position, counted from
+// the top of the stack. It is meant for Go tracers, and reads the variable the
+// same way the decode method of JavaScript tracers does. Storage pointers
+// resolve mapping keys through the given preimages.
+func DecodeVariable(identifier string, position int, stack *vm.Stack, memory *vm.Memory, contract *vm.Contract, db vm.StateDB, preimages *Preimages) interface{} {
+	t, err := types.ParseTypeIdentifier(identifier)
+	if err != nil {
+		return nil
+	}
+
+	decoder := &variableDecoder{
+		stack:    stack,
+		memory:   memory,
+		contract: contract,
+		storage:  newStorageDecoder(db, contract, preimages),
+	}
+
+	return decoder.decode(t, position)
+}
+
+// StackSize returns the number of stack slots a variable with the given type
+// identifier takes.
+func StackSize(identifier string) int {
+	t, err := types.ParseTypeIdentifier(identifier)
+	if err != nil {
+		return 1
+	}
+
+	return stackSize(t)
+}
+
+// DecodeStateVariables decodes the state variables of the contract from
+// storage. Mappings hold the entries whose keys the given preimages record.
+func DecodeStateVariables(db vm.StateDB, contract *vm.Contract, preimages *Preimages) []*StorageVariable {
+	return newStorageDecoder(db, contract, preimages).variables()
+}
@@ -54,6 +54,12 @@ func (ethSchema) GetBlockByNumber(num ethereum.Number) (*jsonrpc2.Request, ether
 	return jsonrpc2.NewRequest("eth_getBlockByNumber", num.Hex(), true), &block
 }
 
+func (ethSchema) GetBlockHeaderByNumber(num ethereum.Number) (*jsonrpc2.Request, ethereum.BlockHeader) {
+	var block BlockHeader
+
+	return jsonrpc2.NewRequest("eth_getBlockByNumber", num.Hex(), false), &block
+}
+
 func (ethSchema) GetBlockByHash(hash string) (*jsonrpc2.Request, ethereum.BlockHeader) {
 	var block BlockHeader
 
@@ -78,6 +84,12 @@ func (ethSchema) GetBalance(address string, block ethereum.Number) (*jsonrpc2.Re
 	return jsonrpc2.NewRequest("eth_getBalance", address, block), &balance
 }
 
+func (ethSchema) GetTransactionCount(address string, block ethereum.Number) (*jsonrpc2.Request, *hexutil.Uint64) {
+	var nonce hexutil.Uint64
+
+	return jsonrpc2.NewRequest("eth_getTransactionCount", address, block), &nonce
+}
+
 func (ethSchema) GetCode(address string, block ethereum.Number) (*jsonrpc2.Request, *string) {
 	var code string
 
@@ -136,6 +136,10 @@ type Log struct {
 	ValueType                string   `json:"type"`
 }
 
+func (l *Log) Address() string {
+	return l.ValueAddress
+}
+
 func (l *Log) Data() string {
 	return l.ValueData
 }
@@ -300,25 +304,26 @@ func (c *CallTrace) Error() string {
 }
 
 func (c *CallTrace) Traces() []ethereum.Trace {
-	ch := make(chan *CallTrace)
-	Walk(c, ch)
-
 	var traces []ethereum.Trace
-	for callTrace := range ch {
+	for _, callTrace := range Walk(c) {
 		traces = append(traces, callTrace)
 	}
 
 	return traces
 }
 
-func Walk(c *CallTrace, ch chan *CallTrace) {
+// Walk flattens the call tree in execution order.
+func Walk(c *CallTrace) []*CallTrace {
 	if c == nil {
-		return
+		return nil
 	}
-	ch <- c
-	for _, callTrace := range c.ValueCalls {
-		Walk(&callTrace, ch)
+
+	traces := []*CallTrace{c}
+	for i := range c.ValueCalls {
+		traces = append(traces, Walk(&c.ValueCalls[i])...)
 	}
+
+	return traces
 }
 
 func (gtr *TraceResult) States() []ethereum.EvmState {
@@ -140,6 +140,10 @@ type Log struct {
 	ValueType                string   `json:"type"`
 }
 
+func (l *Log) Address() string {
+	return l.ValueAddress
+}
+
 func (l *Log) Data() string {
 	return l.ValueData
 }
@@ -260,7 +264,7 @@ func (pvs *VmState) Memory() *vm.Memory {
 
 type TraceResult struct {
 	VmTrace   *VmTrace `json:"vmTrace"`
-	CallTrace []*Trace `json:"traceSchema"`
+	CallTrace []*Trace `json:"trace"`
 }
 
 type VmTrace struct {
@@ -282,7 +286,7 @@ func (tr *TraceResult) States() []ethereum.EvmState {
 }
 
 func (tr *TraceResult) Traces() []ethereum.Trace {
-	if tr.VmTrace == nil {
+	if tr.CallTrace == nil {
 		return []ethereum.Trace{}
 	}
 
@@ -386,6 +390,10 @@ func (t *Trace) TransactionHash() *common.Hash {
 }
 
 func (t *Trace) Type() string {
+	if t.ValueAction.CallType != "" {
+		return t.ValueAction.CallType
+	}
+
 	return t.ValueType
 }
 
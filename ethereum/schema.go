@@ -18,10 +18,12 @@ type Schema interface {
 type EthSchema interface {
 	BlockNumber() (*jsonrpc2.Request, *Number)
 	GetBlockByNumber(num Number) (*jsonrpc2.Request, Block)
+	GetBlockHeaderByNumber(num Number) (*jsonrpc2.Request, BlockHeader)
 	GetBlockByHash(hash string) (*jsonrpc2.Request, BlockHeader)
 	GetTransaction(hash string) (*jsonrpc2.Request, Transaction)
 	GetTransactionReceipt(hash string) (*jsonrpc2.Request, TransactionReceipt)
 	GetBalance(address string, block Number) (*jsonrpc2.Request, *hexutil.Big)
+	GetTransactionCount(address string, block Number) (*jsonrpc2.Request, *hexutil.Uint64)
 	GetCode(address string, block Number) (*jsonrpc2.Request, *string)
 	GetStorage(address string, offset common.Hash, block Number) (*jsonrpc2.Request, *string)
 }
@@ -0,0 +1,53 @@
+package abi
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Error is a custom error a contract can revert with. The revert data of a
+// custom error starts with its 4-byte selector, followed by its ABI-encoded
+// inputs, the same way calldata of a method call does.
+type Error struct {
+	Name   string
+	Inputs Arguments
+}
+
+// Sig returns the error's string signature according to the ABI spec.
+//
+// Example
+//
+//	error InsufficientBalance(uint256 available, uint required)    =    "InsufficientBalance(uint256,uint256)"
+func (e Error) Sig() string {
+	types := make([]string, len(e.Inputs))
+	for i, input := range e.Inputs {
+		types[i] = input.Type.String()
+	}
+	return fmt.Sprintf("%v(%v)", e.Name, strings.Join(types, ","))
+}
+
+func (e Error) String() string {
+	inputs := make([]string, len(e.Inputs))
+	for i, input := range e.Inputs {
+		inputs[i] = fmt.Sprintf("%v %v", input.Name, input.Type)
+	}
+	return fmt.Sprintf("error %v(%v)", e.Name, strings.Join(inputs, ", "))
+}
+
+// Id returns the selector revert data of the error starts with.
+func (e Error) Id() []byte {
+	sha := sha3.NewLegacyKeccak256()
+	sha.Write([]byte(e.Sig()))
+	return sha.Sum(nil)[:4]
+}
+
+// Unpack decodes the inputs of the error from its revert data.
+func (e Error) Unpack(data []byte) ([]interface{}, error) {
+	if len(data) < 4 || !bytes.Equal(data[:4], e.Id()) {
+		return nil, fmt.Errorf("abi: revert data doesn't match error %s", e.Sig())
+	}
+	return e.Inputs.UnpackValues(data[4:])
+}
@@ -44,17 +44,20 @@ func (e Event) String() string {
 	return fmt.Sprintf("e %v(%v)", e.Name, strings.Join(inputs, ", "))
 }
 
-// Id returns the canonical representation of the event's signature used by the
-// abi definition to identify event names and types.
-func (e Event) Id() common.Hash {
+// Sig returns the event's string signature according to the ABI spec, e.g.
+// "Transfer(address,address,uint256)".
+func (e Event) Sig() string {
 	types := make([]string, len(e.Inputs))
-	i := 0
-	for _, input := range e.Inputs {
+	for i, input := range e.Inputs {
 		types[i] = input.Type.String()
-		i++
 	}
+	return fmt.Sprintf("%v(%v)", e.Name, strings.Join(types, ","))
+}
 
+// Id returns the canonical representation of the event's signature used by the
+// abi definition to identify event names and types.
+func (e Event) Id() common.Hash {
 	sha := sha3.NewLegacyKeccak256()
-	sha.Write([]byte(fmt.Sprintf("%v(%v)", e.Name, strings.Join(types, ","))))
+	sha.Write([]byte(e.Sig()))
 	return common.BytesToHash(sha.Sum(nil))
 }
@@ -0,0 +1,71 @@
+package abi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var (
+	// revertSelector is the selector of Error(string), which require and
+	// revert with a reason string revert with.
+	revertSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+	// panicSelector is the selector of Panic(uint256), which failed asserts
+	// and checks inserted by the compiler revert with.
+	panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+
+	errNotRevertReason = errors.New("abi: revert data isn't an Error(string)")
+	errNotPanic        = errors.New("abi: revert data isn't a Panic(uint256)")
+)
+
+// panicReasons maps the codes of Panic(uint256) to what caused them, see
+// https://docs.soliditylang.org/en/latest/control-structures.html#panic-via-assert-and-error-via-require
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler inserted panic",
+	0x01: "assert(false)",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "conversion of an out of range value to an enum",
+	0x22: "access to an incorrectly encoded storage byte array",
+	0x31: "pop() on an empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to a zero-initialized internal function",
+}
+
+// UnpackRevert decodes the reason string of revert data encoded as
+// Error(string).
+func UnpackRevert(data []byte) (string, error) {
+	if len(data) < 4 || !bytes.Equal(data[:4], revertSelector) {
+		return "", errNotRevertReason
+	}
+
+	typ, err := NewType("string")
+	if err != nil {
+		return "", err
+	}
+	values, err := (Arguments{{Type: typ}}).UnpackValues(data[4:])
+	if err != nil {
+		return "", err
+	}
+	return values[0].(string), nil
+}
+
+// UnpackPanic decodes the code of revert data encoded as Panic(uint256).
+func UnpackPanic(data []byte) (*big.Int, error) {
+	if len(data) != 4+32 || !bytes.Equal(data[:4], panicSelector) {
+		return nil, errNotPanic
+	}
+	return new(big.Int).SetBytes(data[4:]), nil
+}
+
+// PanicReason returns what causes a panic with the given code.
+func PanicReason(code *big.Int) string {
+	if code.IsUint64() {
+		if reason, ok := panicReasons[code.Uint64()]; ok {
+			return reason
+		}
+	}
+	return fmt.Sprintf("unknown panic code %#x", code)
+}
@@ -0,0 +1,59 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strconv"
+)
+
+var tupleT = reflect.TypeOf(Tuple{})
+
+// TupleField is a component of an unpacked tuple.
+type TupleField struct {
+	Name  string
+	Value interface{}
+}
+
+// Tuple is an unpacked tuple, holding its components in order. Tuples nest,
+// so unpacked arguments form a tree of names and values.
+type Tuple []TupleField
+
+// MarshalJSON encodes the tuple as an object keeping the order of its
+// components. Components are keyed by their position if any is unnamed.
+func (t Tuple) MarshalJSON() ([]byte, error) {
+	named := true
+	for _, field := range t {
+		if field.Name == "" {
+			named = false
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range t {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		name := field.Name
+		if !named {
+			name = strconv.Itoa(i)
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(field.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
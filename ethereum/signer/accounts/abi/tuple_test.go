@@ -0,0 +1,99 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+const tupleABI = `[{"type":"function","name":"f","inputs":[
+	{"name":"orders","type":"tuple[]","components":[{"name":"amount","type":"uint256"},{"name":"memo","type":"string"}]},
+	{"name":"flags","type":"uint8[2]"},
+	{"name":"tags","type":"string[]"},
+	{"name":"limit","type":"tuple","components":[{"name":"value","type":"uint256"},{"name":"strict","type":"bool"}]}
+]}]`
+
+func TestTupleSignature(t *testing.T) {
+	abi, err := JSON(strings.NewReader(tupleABI))
+	if err != nil {
+		t.Fatalf("failed parsing abi: %v", err)
+	}
+
+	want := "f((uint256,string)[],uint8[2],string[],(uint256,bool))"
+	if sig := abi.Methods["f"].Sig(); sig != want {
+		t.Errorf("signature mismatch: have %s, want %s", sig, want)
+	}
+
+	typ, err := NewType("(uint256,(address,bytes32)[2])[]")
+	if err != nil {
+		t.Fatalf("failed parsing tuple type: %v", err)
+	}
+	if typ.T != SliceTy || typ.Elem.T != TupleTy || typ.Elem.TupleElems[1].Elem.T != TupleTy {
+		t.Errorf("nested tuple parsed wrong: %+v", typ)
+	}
+}
+
+func TestTuplePack(t *testing.T) {
+	abi, err := JSON(strings.NewReader(tupleABI))
+	if err != nil {
+		t.Fatalf("failed parsing abi: %v", err)
+	}
+	orders := abi.Methods["f"].Inputs[:1]
+
+	packed, err := orders.Pack([]Tuple{{{"amount", big.NewInt(1)}, {"memo", "ab"}}})
+	if err != nil {
+		t.Fatalf("failed packing: %v", err)
+	}
+	want := "0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"0000000000000000000000000000000000000000000000000000000000000040" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"6162000000000000000000000000000000000000000000000000000000000000"
+	if have := hex.EncodeToString(packed); have != want {
+		t.Errorf("encoding mismatch:\nhave %s\nwant %s", have, want)
+	}
+}
+
+func TestTupleUnpack(t *testing.T) {
+	abi, err := JSON(strings.NewReader(tupleABI))
+	if err != nil {
+		t.Fatalf("failed parsing abi: %v", err)
+	}
+	inputs := abi.Methods["f"].Inputs
+
+	packed, err := inputs.Pack(
+		[]Tuple{{{"amount", big.NewInt(1)}, {"memo", "ab"}}},
+		[2]uint8{3, 4},
+		[]string{"x", "yz"},
+		Tuple{{"value", big.NewInt(7)}, {"strict", true}},
+	)
+	if err != nil {
+		t.Fatalf("failed packing: %v", err)
+	}
+
+	values, err := inputs.UnpackValues(packed)
+	if err != nil {
+		t.Fatalf("failed unpacking: %v", err)
+	}
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		t.Fatalf("failed encoding values: %v", err)
+	}
+	want := `[[{"amount":1,"memo":"ab"}],[3,4],["x","yz"],{"value":7,"strict":true}]`
+	if string(encoded) != want {
+		t.Errorf("values mismatch:\nhave %s\nwant %s", encoded, want)
+	}
+
+	repacked, err := inputs.PackValues(values)
+	if err != nil {
+		t.Fatalf("failed repacking: %v", err)
+	}
+	if !bytes.Equal(repacked, packed) {
+		t.Errorf("repacked values differ:\nhave %x\nwant %x", repacked, packed)
+	}
+}
@@ -38,6 +38,7 @@ const (
 	HashTy
 	FixedPointTy
 	FunctionTy
+	TupleTy
 )
 
 // Type is the reflection of the supported argument type
@@ -49,6 +50,11 @@ type Type struct {
 	Size int
 	T    byte // Our own type checking
 
+	// TupleElems and TupleRawNames hold the types and names of the
+	// components of tuples, in order.
+	TupleElems    []*Type
+	TupleRawNames []string
+
 	stringKind string // holds the unparsed string for deriving signatures
 }
 
@@ -57,8 +63,17 @@ var (
 	typeRegex = regexp.MustCompile("([a-zA-Z]+)(([0-9]+)(x([0-9]+))?)?")
 )
 
-// NewType creates a new reflection type of abi type given in t.
+// NewType creates a new reflection type of abi type given in t. Tuples may
+// be given by their components in parentheses, e.g. (uint256,address)[],
+// which leaves the components unnamed.
 func NewType(t string) (typ Type, err error) {
+	return NewTypeWithComponents(t, nil)
+}
+
+// NewTypeWithComponents creates a new reflection type of abi type given in t,
+// taking the components of a tuple, or of the tuples an array holds, from
+// the given components the way the JSON ABI lists them.
+func NewTypeWithComponents(t string, components []ArgumentMarshaling) (typ Type, err error) {
 	// check that array brackets are equal if they exist
 	if strings.Count(t, "[") != strings.Count(t, "]") {
 		return Type{}, fmt.Errorf("invalid arg type in abi")
@@ -68,13 +83,15 @@ func NewType(t string) (typ Type, err error) {
 
 	// if there are brackets, get ready to go into slice/array mode and
 	// recursively create the type
-	if strings.Count(t, "[") != 0 {
+	if strings.HasSuffix(t, "]") {
 		i := strings.LastIndex(t, "[")
 		// recursively embed the type
-		embeddedType, err := NewType(t[:i])
+		embeddedType, err := NewTypeWithComponents(t[:i], components)
 		if err != nil {
 			return Type{}, err
 		}
+		// tuples are named by their components in signatures
+		typ.stringKind = embeddedType.stringKind + t[i:]
 		// grab the last cell and create a type from there
 		sliced := t[i:]
 		// grab the slice size with regexp
@@ -102,6 +119,16 @@ func NewType(t string) (typ Type, err error) {
 		}
 		return typ, err
 	}
+	if t == "tuple" {
+		return newTupleType(components)
+	}
+	if strings.HasPrefix(t, "(") && strings.HasSuffix(t, ")") {
+		components = nil
+		for _, component := range splitTupleComponents(t[1 : len(t)-1]) {
+			components = append(components, ArgumentMarshaling{Type: component})
+		}
+		return newTupleType(components)
+	}
 	// parse the type and size of the abi-type.
 	parsedType := typeRegex.FindAllStringSubmatch(t, -1)[0]
 	// varSize is the size of the variable
@@ -165,6 +192,55 @@ func NewType(t string) (typ Type, err error) {
 	return
 }
 
+// newTupleType creates the type of a tuple made of the components.
+func newTupleType(components []ArgumentMarshaling) (Type, error) {
+	typ := Type{
+		T:    TupleTy,
+		Kind: reflect.Slice,
+		Type: tupleT,
+	}
+
+	var elems []string
+	for _, component := range components {
+		elem, err := NewTypeWithComponents(component.Type, component.Components)
+		if err != nil {
+			return Type{}, err
+		}
+		typ.TupleElems = append(typ.TupleElems, &elem)
+		typ.TupleRawNames = append(typ.TupleRawNames, component.Name)
+		elems = append(elems, elem.String())
+	}
+	typ.stringKind = "(" + strings.Join(elems, ",") + ")"
+
+	return typ, nil
+}
+
+// splitTupleComponents splits the comma separated components of a tuple,
+// leaving the components of nested tuples together.
+func splitTupleComponents(list string) []string {
+	if list == "" {
+		return nil
+	}
+
+	var components []string
+	depth, start := 0, 0
+	for i, c := range list {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				components = append(components, list[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(components, list[start:])
+}
+
 // String implements Stringer
 func (t Type) String() (out string) {
 	return t.stringKind
@@ -178,27 +254,105 @@ func (t Type) pack(v reflect.Value) ([]byte, error) {
 		return nil, err
 	}
 
-	if t.T == SliceTy || t.T == ArrayTy {
-		var packed []byte
+	switch t.T {
+	case SliceTy, ArrayTy:
+		elems := make([]*Type, v.Len())
+		values := make([]reflect.Value, v.Len())
+		for i := range elems {
+			elems[i] = t.Elem
+			values[i] = v.Index(i)
+		}
 
-		for i := 0; i < v.Len(); i++ {
-			val, err := t.Elem.pack(v.Index(i))
-			if err != nil {
-				return nil, err
-			}
-			packed = append(packed, val...)
+		packed, err := packSequence(elems, values)
+		if err != nil {
+			return nil, err
 		}
 		if t.T == SliceTy {
-			return packBytesSlice(packed, v.Len()), nil
-		} else if t.T == ArrayTy {
-			return packed, nil
+			return append(packNum(reflect.ValueOf(v.Len())), packed...), nil
+		}
+		return packed, nil
+	case TupleTy:
+		tuple, ok := v.Interface().(Tuple)
+		if !ok || len(tuple) != len(t.TupleElems) {
+			return nil, typeErr(t.String(), v.Type())
+		}
+
+		values := make([]reflect.Value, len(tuple))
+		for i, field := range tuple {
+			values[i] = reflect.ValueOf(field.Value)
 		}
+		return packSequence(t.TupleElems, values)
 	}
 	return packElement(t, v), nil
 }
 
+// packSequence packs the values of the types one after the other, the way
+// the elements of arrays and the components of tuples are. Values of dynamic
+// types are appended after all the others, in their place is their offset.
+func packSequence(types []*Type, values []reflect.Value) ([]byte, error) {
+	offset := 0
+	for _, t := range types {
+		offset += getTypeSize(*t)
+	}
+
+	var head, tail []byte
+	for i, t := range types {
+		packed, err := t.pack(values[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if isDynamicType(*t) {
+			head = append(head, packNum(reflect.ValueOf(offset+len(tail)))...)
+			tail = append(tail, packed...)
+		} else {
+			head = append(head, packed...)
+		}
+	}
+
+	return append(head, tail...), nil
+}
+
 // requireLengthPrefix returns whether the type requires any sort of length
 // prefixing.
 func (t Type) requiresLengthPrefix() bool {
 	return t.T == StringTy || t.T == BytesTy || t.T == SliceTy
 }
+
+// isDynamicType returns whether values of the type are encoded in the tail,
+// with only their offset in place.
+func isDynamicType(t Type) bool {
+	switch t.T {
+	case StringTy, BytesTy, SliceTy:
+		return true
+	case ArrayTy:
+		return isDynamicType(*t.Elem)
+	case TupleTy:
+		for _, elem := range t.TupleElems {
+			if isDynamicType(*elem) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getTypeSize returns the number of bytes a value of the type takes in
+// place, which is a single offset for dynamic types.
+func getTypeSize(t Type) int {
+	if isDynamicType(t) {
+		return 32
+	}
+
+	switch t.T {
+	case ArrayTy:
+		return t.Size * getTypeSize(*t.Elem)
+	case TupleTy:
+		size := 0
+		for _, elem := range t.TupleElems {
+			size += getTypeSize(*elem)
+		}
+		return size
+	}
+	return 32
+}
@@ -15,6 +15,13 @@ type DecodedCallData struct {
 	Inputs    []DecodedArgument
 }
 
+// DecodedLog is a log decoded against the event that emitted it.
+type DecodedLog struct {
+	Signature string
+	Name      string
+	Inputs    []DecodedArgument
+}
+
 type DecodedArgument struct {
 	Soltype abi.Argument
 	Value   interface{}
@@ -74,7 +81,7 @@ func ParseCallData(calldata []byte, abidata string) (*DecodedCallData, error) {
 		return nil, fmt.Errorf("Invalid ABI-data, incomplete method signature of (%d bytes)", len(calldata))
 	}
 
-	sigdata, argdata := calldata[:4], calldata[4:]
+	argdata := calldata[4:]
 	if len(argdata)%32 != 0 {
 		return nil, fmt.Errorf("Not ABI-encoded data; length should be a multiple of 32 (was %d)", len(argdata))
 	}
@@ -84,36 +91,23 @@ func ParseCallData(calldata []byte, abidata string) (*DecodedCallData, error) {
 		return nil, fmt.Errorf("Failed parsing JSON ABI: %v, abidata: %v", err, abidata)
 	}
 
-	method, err := abispec.MethodById(sigdata)
-	if err != nil {
-		return nil, err
-	}
-
-	v, err := method.Inputs.UnpackValues(argdata)
+	decoded, err := DecodeCallData(calldata, &abispec)
 	if err != nil {
 		return nil, err
 	}
 
-	decoded := DecodedCallData{Signature: method.Sig(), Name: method.Name}
-
-	for n, transaction := range method.Inputs {
-		if err != nil {
-			return nil, fmt.Errorf("Failed to decode transaction %d (signature %v): %v", n, method.Sig(), err)
-		}
-		decodedArg := DecodedArgument{
-			Soltype: transaction,
-			Value:   v[n],
-		}
-		decoded.Inputs = append(decoded.Inputs, decodedArg)
-	}
-
 	// We're finished decoding the data. At this point, we encode the decoded data to see if it matches with the
 	// original data. If we didn't do that, it would e.g. be possible to stuff extra data into the transactions, which
 	// is not detected by merely decoding the data.
 
 	var (
 		encoded []byte
+		v       []interface{}
 	)
+	for _, arg := range decoded.Inputs {
+		v = append(v, arg.Value)
+	}
+	method, _ := abispec.MethodById(calldata)
 	encoded, err = method.Inputs.PackValues(v)
 
 	if err != nil {
@@ -125,5 +119,105 @@ func ParseCallData(calldata []byte, abidata string) (*DecodedCallData, error) {
 		exp := common.Bytes2Hex(argdata)
 		return nil, fmt.Errorf("WARNING: Supplied data is stuffed with extra data. \nWant %s\nHave %s\nfor method %v", exp, was, method.Sig())
 	}
-	return &decoded, nil
+	return decoded, nil
+}
+
+// DecodeCallData decodes calldata against an already parsed ABI. Unlike
+// ParseCallData it doesn't require the arguments to be canonically encoded,
+// which calls made by contracts aren't always.
+func DecodeCallData(calldata []byte, abispec *abi.ABI) (*DecodedCallData, error) {
+	if len(calldata) < 4 {
+		return nil, fmt.Errorf("Invalid ABI-data, incomplete method signature of (%d bytes)", len(calldata))
+	}
+
+	method, err := abispec.MethodById(calldata)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, err := DecodeArguments(method.Inputs, calldata[4:])
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decode call data (signature %v): %v", method.Sig(), err)
+	}
+
+	return &DecodedCallData{Signature: method.Sig(), Name: method.Name, Inputs: inputs}, nil
+}
+
+// DecodeReturnData decodes the data a call to the method returned with.
+func DecodeReturnData(output []byte, method *abi.Method) ([]DecodedArgument, error) {
+	outputs, err := DecodeArguments(method.Outputs, output)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decode return data (signature %v): %v", method.Sig(), err)
+	}
+
+	return outputs, nil
+}
+
+// DecodeArguments unpacks ABI-encoded arguments and pairs every value with
+// the argument it belongs to.
+func DecodeArguments(arguments abi.Arguments, data []byte) ([]DecodedArgument, error) {
+	values, err := arguments.UnpackValues(data)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := make([]DecodedArgument, 0, len(values))
+	for n, argument := range arguments.NonIndexed() {
+		decoded = append(decoded, DecodedArgument{
+			Soltype: argument,
+			Value:   values[n],
+		})
+	}
+
+	return decoded, nil
+}
+
+// DecodeLog decodes the topics and data of a log emitted by the event. The
+// first topic is the event id, unless the event is anonymous. Indexed
+// arguments of reference types are only kept as the hash of their value, so
+// they are decoded to that hash.
+func DecodeLog(event *abi.Event, topics []common.Hash, data []byte) ([]DecodedArgument, error) {
+	if !event.Anonymous {
+		if len(topics) == 0 || topics[0] != event.Id() {
+			return nil, fmt.Errorf("Log doesn't match event %v", event.Name)
+		}
+		topics = topics[1:]
+	}
+
+	values, err := event.Inputs.UnpackValues(data)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decode log data of event %v: %v", event.Name, err)
+	}
+
+	decoded := make([]DecodedArgument, 0, len(event.Inputs))
+	for _, input := range event.Inputs {
+		if !input.Indexed {
+			decoded = append(decoded, DecodedArgument{Soltype: input, Value: values[0]})
+			values = values[1:]
+			continue
+		}
+
+		if len(topics) == 0 {
+			return nil, fmt.Errorf("Missing topic of indexed argument %v of event %v", input.Name, event.Name)
+		}
+		topic := topics[0]
+		topics = topics[1:]
+
+		switch input.Type.T {
+		case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+			hashType, _ := abi.NewType("bytes32")
+			decoded = append(decoded, DecodedArgument{Soltype: abi.Argument{Name: input.Name, Type: hashType, Indexed: true}, Value: topic})
+		default:
+			value, err := abi.Arguments{{Type: input.Type}}.UnpackValues(topic.Bytes())
+			if err != nil {
+				return nil, fmt.Errorf("Failed to decode topic of argument %v of event %v: %v", input.Name, event.Name, err)
+			}
+			decoded = append(decoded, DecodedArgument{Soltype: input, Value: value[0]})
+		}
+	}
+	if len(topics) != 0 {
+		return nil, fmt.Errorf("Too many topics for event %v", event.Name)
+	}
+
+	return decoded, nil
 }
@@ -0,0 +1,140 @@
+package fourbyte
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tenderly/tenderly-trace/ethereum/signer/accounts/abi"
+	"github.com/tenderly/tenderly-trace/ethereum/signer/accounts/signer/core"
+)
+
+// DecodeCall decodes calldata against every function signature its selector
+// matches, keeping the ones the arguments are a canonical encoding of. The
+// first match is returned together with the signatures of all the matches,
+// more than one of which means the call is ambiguous.
+func (db *Database) DecodeCall(input []byte) (*core.DecodedCallData, []string, error) {
+	if len(input) < 4 {
+		return nil, nil, fmt.Errorf("calldata too short (%d bytes) for a selector", len(input))
+	}
+
+	candidates := db.Functions(input)
+	if len(candidates) == 0 {
+		return nil, nil, fmt.Errorf("no function with selector %#x", input[:4])
+	}
+
+	var decoded *core.DecodedCallData
+	var matches []string
+	for _, signature := range candidates {
+		name, inputs, err := parseSignature(signature)
+		if err != nil {
+			continue
+		}
+
+		arguments, err := core.DecodeArguments(inputs, input[4:])
+		if err != nil || !canonical(inputs, arguments, input[4:]) {
+			continue
+		}
+
+		if decoded == nil {
+			decoded = &core.DecodedCallData{Signature: signature, Name: name, Inputs: arguments}
+		}
+		matches = append(matches, signature)
+	}
+	if decoded == nil {
+		return nil, nil, fmt.Errorf("calldata matches none of %s", strings.Join(candidates, ", "))
+	}
+
+	return decoded, matches, nil
+}
+
+// DecodeLog decodes a log against every event signature its first topic
+// matches. Signatures don't tell which arguments are indexed, so the first
+// ones are taken to be, as many as there are topics after the first. The
+// first match is returned together with the signatures of all the matches.
+func (db *Database) DecodeLog(topics []common.Hash, data []byte) (*core.DecodedLog, []string, error) {
+	if len(topics) == 0 {
+		return nil, nil, fmt.Errorf("anonymous logs can't be looked up")
+	}
+
+	candidates := db.Events(topics[0])
+	if len(candidates) == 0 {
+		return nil, nil, fmt.Errorf("no event with topic %s", topics[0].Hex())
+	}
+
+	var decoded *core.DecodedLog
+	var matches []string
+	for _, signature := range candidates {
+		name, inputs, err := parseSignature(signature)
+		if err != nil || len(topics)-1 > len(inputs) {
+			continue
+		}
+		for i := 0; i < len(topics)-1; i++ {
+			inputs[i].Indexed = true
+		}
+
+		event := &abi.Event{Name: name, Inputs: inputs}
+		arguments, err := core.DecodeLog(event, topics, data)
+		if err != nil {
+			continue
+		}
+
+		var values []core.DecodedArgument
+		for _, argument := range arguments {
+			if !argument.Soltype.Indexed {
+				values = append(values, argument)
+			}
+		}
+		if !canonical(inputs.NonIndexed(), values, data) {
+			continue
+		}
+
+		if decoded == nil {
+			decoded = &core.DecodedLog{Signature: signature, Name: name, Inputs: arguments}
+		}
+		matches = append(matches, signature)
+	}
+	if decoded == nil {
+		return nil, nil, fmt.Errorf("log matches none of %s", strings.Join(candidates, ", "))
+	}
+
+	return decoded, matches, nil
+}
+
+// canonical reports whether the data is exactly the encoding of the decoded
+// arguments. Data decoded against the wrong signature rarely is.
+func canonical(arguments abi.Arguments, decoded []core.DecodedArgument, data []byte) bool {
+	values := make([]interface{}, len(decoded))
+	for i, argument := range decoded {
+		values[i] = argument.Value
+	}
+
+	encoded, err := arguments.PackValues(values)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(encoded, data)
+}
+
+// parseSignature splits a signature like transfer(address,uint256) into its
+// name and unnamed arguments.
+func parseSignature(signature string) (string, abi.Arguments, error) {
+	open := strings.Index(signature, "(")
+	if open <= 0 || !strings.HasSuffix(signature, ")") {
+		return "", nil, fmt.Errorf("invalid signature %s", signature)
+	}
+
+	// The arguments are laid out the same way the components of a tuple are.
+	tuple, err := abi.NewType(signature[open:])
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid types in signature %s, err: %s", signature, err)
+	}
+
+	var arguments abi.Arguments
+	for _, elem := range tuple.TupleElems {
+		arguments = append(arguments, abi.Argument{Type: *elem})
+	}
+
+	return signature[:open], arguments, nil
+}
@@ -0,0 +1,196 @@
+// Package fourbyte is a database of function selectors and event topics,
+// mapped to the signatures they were derived from. It lets calls and logs of
+// contracts without artifacts be decoded.
+package fourbyte
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Database maps function selectors and event topics to signatures. Several
+// signatures may share a selector, since a selector is only the first four
+// bytes of the hash of a signature.
+type Database struct {
+	functions map[[4]byte][]string
+	events    map[common.Hash][]string
+}
+
+// New creates an empty database.
+func New() *Database {
+	return &Database{
+		functions: make(map[[4]byte][]string),
+		events:    make(map[common.Hash][]string),
+	}
+}
+
+// NewFromFile creates a database seeded from a JSON or text file, see Load.
+func NewFromFile(path string) (*Database, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading signature database, err: %s", err)
+	}
+
+	db := New()
+	if err := db.Load(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("failed loading signature database %s, err: %s", path, err)
+	}
+
+	return db, nil
+}
+
+// Load adds the signatures read from either a JSON object or text lines.
+//
+// The JSON object maps hex selectors or topics to a signature or to a list of
+// them:
+//
+//	{"a9059cbb": "transfer(address,uint256)", "0xddf252ad…": ["Transfer(address,address,uint256)"]}
+//
+// Text lines hold a hex selector or topic followed by a signature. Empty
+// lines and lines starting with # are skipped:
+//
+//	0xa9059cbb transfer(address,uint256)
+//
+// Keys of four bytes are function selectors and keys of 32 bytes are event
+// topics. Entries whose key isn't derived from their signature are ignored.
+func (db *Database) Load(r io.Reader) error {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		return db.loadJSON(trimmed)
+	}
+	return db.loadText(data)
+}
+
+func (db *Database) loadJSON(data []byte) error {
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+
+	for key, raw := range entries {
+		var signatures []string
+		if err := json.Unmarshal(raw, &signatures); err != nil {
+			var signature string
+			if err := json.Unmarshal(raw, &signature); err != nil {
+				return fmt.Errorf("invalid signatures for %s", key)
+			}
+			signatures = []string{signature}
+		}
+
+		for _, signature := range signatures {
+			if err := db.add(key, signature); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (db *Database) loadText(data []byte) error {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return fmt.Errorf("invalid signature on line %d", line)
+		}
+		if err := db.add(fields[0], fields[1]); err != nil {
+			return fmt.Errorf("line %d: %s", line, err)
+		}
+	}
+
+	return scanner.Err()
+}
+
+func (db *Database) add(key string, signature string) error {
+	id, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+	if err != nil {
+		return fmt.Errorf("invalid selector %s", key)
+	}
+
+	signature = strings.TrimSpace(signature)
+	hash := crypto.Keccak256([]byte(signature))
+	switch len(id) {
+	case 4:
+		if bytes.Equal(id, hash[:4]) {
+			db.AddFunction(signature)
+		}
+	case 32:
+		if bytes.Equal(id, hash) {
+			db.AddEvent(signature)
+		}
+	default:
+		return fmt.Errorf("invalid selector %s", key)
+	}
+
+	return nil
+}
+
+// AddFunction adds the signature of a function, e.g. transfer(address,uint256).
+func (db *Database) AddFunction(signature string) {
+	signature = strings.TrimSpace(signature)
+
+	var selector [4]byte
+	copy(selector[:], crypto.Keccak256([]byte(signature)))
+
+	db.functions[selector] = insert(db.functions[selector], signature)
+}
+
+// AddEvent adds the signature of an event, e.g.
+// Transfer(address,address,uint256).
+func (db *Database) AddEvent(signature string) {
+	signature = strings.TrimSpace(signature)
+	topic := crypto.Keccak256Hash([]byte(signature))
+
+	db.events[topic] = insert(db.events[topic], signature)
+}
+
+// Functions returns the signatures of the functions calldata starting with
+// the selector may call, sorted.
+func (db *Database) Functions(selector []byte) []string {
+	if len(selector) < 4 {
+		return nil
+	}
+
+	var key [4]byte
+	copy(key[:], selector)
+	return db.functions[key]
+}
+
+// Events returns the signatures of the events logs with the topic may have
+// been emitted by, sorted.
+func (db *Database) Events(topic common.Hash) []string {
+	return db.events[topic]
+}
+
+// insert adds the signature to the sorted signatures, unless already present.
+func insert(signatures []string, signature string) []string {
+	i := sort.SearchStrings(signatures, signature)
+	if i < len(signatures) && signatures[i] == signature {
+		return signatures
+	}
+
+	signatures = append(signatures, "")
+	copy(signatures[i+1:], signatures[i:])
+	signatures[i] = signature
+	return signatures
+}
@@ -0,0 +1,110 @@
+package fourbyte
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// burn(uint256) and collate_propagate_storage(bytes16) share their selector.
+const (
+	burn    = "burn(uint256)"
+	collate = "collate_propagate_storage(bytes16)"
+)
+
+var transferTopic = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+func TestLoad(t *testing.T) {
+	db := New()
+	err := db.Load(strings.NewReader(`{
+		"0xa9059cbb": "transfer(address,uint256)",
+		"42966c68": ["burn(uint256)", "collate_propagate_storage(bytes16)", "mint(uint256)"],
+		"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef": "Transfer(address,address,uint256)"
+	}`))
+	if err != nil {
+		t.Fatalf("failed loading JSON: %s", err)
+	}
+
+	err = db.Load(strings.NewReader("# approvals\n\n0x095ea7b3 approve(address,uint256)\n"))
+	if err != nil {
+		t.Fatalf("failed loading text: %s", err)
+	}
+
+	if functions := db.Functions(hexutil.MustDecode("0xa9059cbb")); len(functions) != 1 || functions[0] != "transfer(address,uint256)" {
+		t.Errorf("unexpected transfer functions %v", functions)
+	}
+	if functions := db.Functions(hexutil.MustDecode("0x095ea7b3")); len(functions) != 1 || functions[0] != "approve(address,uint256)" {
+		t.Errorf("unexpected approve functions %v", functions)
+	}
+	// mint(uint256) has another selector and is left out.
+	if functions := db.Functions(hexutil.MustDecode("0x42966c68")); strings.Join(functions, " ") != burn+" "+collate {
+		t.Errorf("expected the colliding functions sorted, got %v", functions)
+	}
+	if events := db.Events(transferTopic); len(events) != 1 || events[0] != "Transfer(address,address,uint256)" {
+		t.Errorf("unexpected transfer events %v", events)
+	}
+
+	if err := db.Load(strings.NewReader("0xa9059c transfer(address,uint256)\n")); err == nil {
+		t.Errorf("expected a selector of the wrong length rejected")
+	}
+}
+
+func TestDecodeCall(t *testing.T) {
+	db := New()
+	db.AddFunction(burn)
+	db.AddFunction(collate)
+
+	// A small number isn't a canonical bytes16, whose bytes are left aligned.
+	input := hexutil.MustDecode("0x42966c68" + "0000000000000000000000000000000000000000000000000000000000000005")
+	decoded, matches, err := db.DecodeCall(input)
+	if err != nil {
+		t.Fatalf("failed decoding: %s", err)
+	}
+	if decoded.Signature != burn || decoded.Name != "burn" || len(matches) != 1 {
+		t.Errorf("expected burn only, got %s with matches %v", decoded.Signature, matches)
+	}
+	if value := decoded.Inputs[0].Value; value == nil || decoded.Inputs[0].Soltype.Type.String() != "uint256" {
+		t.Errorf("unexpected input %+v", decoded.Inputs[0])
+	}
+
+	input = hexutil.MustDecode("0x42966c68" + "0500000000000000000000000000000000000000000000000000000000000000")
+	_, matches, err = db.DecodeCall(input)
+	if err != nil {
+		t.Fatalf("failed decoding: %s", err)
+	}
+	if strings.Join(matches, " ") != burn+" "+collate {
+		t.Errorf("expected both functions to match, got %v", matches)
+	}
+
+	if _, _, err := db.DecodeCall(hexutil.MustDecode("0xa9059cbb")); err == nil {
+		t.Errorf("expected an unknown selector to fail")
+	}
+}
+
+func TestDecodeLog(t *testing.T) {
+	db := New()
+	db.AddEvent("Transfer(address,address,uint256)")
+
+	from := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	to := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	topics := []common.Hash{transferTopic, from.Hash(), to.Hash()}
+	data := common.LeftPadBytes([]byte{0x05}, 32)
+
+	decoded, matches, err := db.DecodeLog(topics, data)
+	if err != nil {
+		t.Fatalf("failed decoding: %s", err)
+	}
+	if decoded.Name != "Transfer" || len(matches) != 1 || len(decoded.Inputs) != 3 {
+		t.Fatalf("unexpected log %+v with matches %v", decoded, matches)
+	}
+	if !decoded.Inputs[0].Soltype.Indexed || !decoded.Inputs[1].Soltype.Indexed || decoded.Inputs[2].Soltype.Indexed {
+		t.Errorf("expected the arguments in topics indexed, got %+v", decoded.Inputs)
+	}
+
+	// Signatures with fewer arguments than topics can't have emitted the log.
+	if _, _, err := db.DecodeLog(append(topics, common.Hash{}, common.Hash{}), data); err == nil {
+		t.Errorf("expected too many topics to fail")
+	}
+}
@@ -76,6 +76,7 @@ type Transaction interface {
 }
 
 type Log interface {
+	Address() string
 	Topics() []string
 	Data() string
 }
@@ -0,0 +1,172 @@
+package brownie
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/tenderly/tenderly-trace/source"
+	"github.com/tenderly/tenderly-trace/source/truffle"
+)
+
+// Artifact is the artifact brownie writes for every compiled contract, to
+// build/contracts/<contract name>.json. Its layout follows truffle, except
+// for code being hex encoded without a 0x prefix, and it also tells the paths
+// of all sources the source maps point into, keyed by their file index.
+type Artifact struct {
+	truffle.Contract
+
+	AllSourcePaths map[string]string `json:"allSourcePaths"`
+	Language       string            `json:"language"`
+	Type           string            `json:"type"`
+}
+
+type ContractSource struct {
+	contracts map[string]source.Contract
+	report    *source.LoadReport
+}
+
+func (cs ContractSource) GetSource() source.ContractSource {
+	contracts := make(map[string]source.Contract)
+	for k, v := range cs.contracts {
+		contracts[k] = v
+	}
+
+	return source.NewContractSource(contracts, nil)
+}
+
+// Report lists the artifacts the Contract Source was built from.
+func (cs ContractSource) Report() *source.LoadReport {
+	return cs.report
+}
+
+// NewContractSource builds the Contract Source from the build/contracts
+// directory of a brownie project, including the contracts of its packaged
+// dependencies.
+func NewContractSource(absBuildDir string) (*ContractSource, error) {
+	report := source.NewLoadReport("brownie", absBuildDir)
+	artifacts, err := loadArtifacts(absBuildDir, report)
+	if err != nil {
+		return nil, err
+	}
+
+	// Brownie compiles the sources requiring each compiler version
+	// separately, and AST ids and file indexes are only unique within a
+	// compilation.
+	builds := make(map[string][]*Artifact)
+	for _, artifact := range artifacts {
+		builds[artifact.Compiler.Version] = append(builds[artifact.Compiler.Version], artifact)
+	}
+
+	contracts := make(map[string]source.Contract)
+	for _, buildArtifacts := range builds {
+		var truffleContracts []*truffle.Contract
+		for _, artifact := range buildArtifacts {
+			truffleContracts = append(truffleContracts, &artifact.Contract)
+		}
+
+		for code, contract := range truffle.MapContracts(truffleContracts, sourceUnits(buildArtifacts)).GetSource().Contracts {
+			contracts[code] = contract
+		}
+	}
+
+	return &ContractSource{
+		contracts: contracts,
+		report:    report,
+	}, nil
+}
+
+// loadArtifacts reads the artifacts of all Solidity contracts with code.
+func loadArtifacts(absBuildDir string, report *source.LoadReport) ([]*Artifact, error) {
+	var artifacts []*Artifact
+	err := filepath.Walk(absBuildDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, ".json") {
+			return nil
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed reading brownie artifact %s, err: %s", path, err)
+		}
+
+		var artifact Artifact
+		err = json.Unmarshal(data, &artifact)
+		if err != nil {
+			report.AddSkipped(path, fmt.Sprintf("failed parsing: %s", err))
+			return nil
+		}
+
+		switch {
+		case artifact.Language != "" && artifact.Language != "Solidity":
+			report.AddSkipped(path, fmt.Sprintf("%s contracts aren't supported", artifact.Language))
+		case strings.TrimPrefix(artifact.DeployedBytecode, "0x") == "":
+			report.AddSkipped(path, "no deployed code")
+		default:
+			artifact.Bytecode = hexPrefix(artifact.Bytecode)
+			artifact.DeployedBytecode = hexPrefix(artifact.DeployedBytecode)
+			artifacts = append(artifacts, &artifact)
+			report.AddLoaded(path, "")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing brownie artifacts: %s", err)
+	}
+
+	return artifacts, nil
+}
+
+// sourceUnits collects the sources of a compilation from the artifacts, which
+// each carry the content and AST of the source they are declared in.
+func sourceUnits(artifacts []*Artifact) []*truffle.SourceUnit {
+	byPath := make(map[string]*Artifact)
+	for _, artifact := range artifacts {
+		byPath[artifact.SourcePath] = artifact
+	}
+
+	units := make(map[int]*truffle.SourceUnit)
+	for _, artifact := range artifacts {
+		for key, path := range artifact.AllSourcePaths {
+			id, err := strconv.Atoi(key)
+			if err != nil {
+				continue
+			}
+			if _, ok := units[id]; ok {
+				continue
+			}
+
+			unit := &truffle.SourceUnit{
+				Id:   id,
+				Path: path,
+			}
+			if declaring, ok := byPath[path]; ok {
+				unit.Content = declaring.Source
+				unit.Ast = &declaring.Ast
+			}
+			units[id] = unit
+		}
+	}
+
+	var sources []*truffle.SourceUnit
+	for _, unit := range units {
+		sources = append(sources, unit)
+	}
+
+	return sources
+}
+
+func hexPrefix(code string) string {
+	if strings.HasPrefix(code, "0x") {
+		return code
+	}
+
+	return "0x" + code
+}
@@ -0,0 +1,30 @@
+package foundry
+
+import (
+	"github.com/tenderly/tenderly-trace/ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/source/solc"
+	"github.com/tenderly/tenderly-trace/source/truffle"
+)
+
+// Artifact is the artifact forge writes for every compiled contract, to
+// out/<source file>/<contract name>.json. Its bytecode objects are hex
+// encoded with a 0x prefix.
+type Artifact struct {
+	Abi               interface{}          `json:"abi"`
+	Bytecode          solc.Bytecode        `json:"bytecode"`
+	DeployedBytecode  solc.Bytecode        `json:"deployedBytecode"`
+	MethodIdentifiers map[string]string    `json:"methodIdentifiers"`
+	StorageLayout     *types.StorageLayout `json:"storageLayout"`
+	Ast               *truffle.ContractAst `json:"ast"`
+	Id                int                  `json:"id"`
+}
+
+// BuildInfo is the solc standard JSON input and output of a compilation, kept
+// in out/build-info when forge is asked to write it.
+type BuildInfo struct {
+	Id              string      `json:"id"`
+	SolcVersion     string      `json:"solcVersion"`
+	SolcLongVersion string      `json:"solcLongVersion"`
+	Input           solc.Input  `json:"input"`
+	Output          solc.Output `json:"output"`
+}
@@ -0,0 +1,272 @@
+package foundry
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tenderly/tenderly-trace/source"
+	"github.com/tenderly/tenderly-trace/source/solc"
+	"github.com/tenderly/tenderly-trace/source/truffle"
+)
+
+type ContractSource struct {
+	contracts map[string]source.Contract
+	report    *source.LoadReport
+}
+
+func (cs ContractSource) GetSource() source.ContractSource {
+	contracts := make(map[string]source.Contract)
+	for k, v := range cs.contracts {
+		contracts[k] = v
+	}
+
+	return source.NewContractSource(contracts, nil)
+}
+
+// Report lists the artifacts the Contract Source was built from.
+func (cs ContractSource) Report() *source.LoadReport {
+	return cs.report
+}
+
+// contractFile is an artifact together with the name of its contract, which
+// forge only keeps in the file name.
+type contractFile struct {
+	path     string
+	name     string
+	artifact *Artifact
+}
+
+// key identifies the compiled contract the artifact was written for.
+func (f *contractFile) key() string {
+	path := ""
+	if f.artifact.Ast != nil {
+		path = f.artifact.Ast.AbsolutePath
+	}
+
+	return path + ":" + f.name + ":" + hexPrefix(f.artifact.DeployedBytecode.Object)
+}
+
+// NewContractSource builds the Contract Source from the output directory of a
+// foundry project. Contracts are taken from the build info of the compilation
+// which produced them when forge wrote one, and from their artifacts
+// otherwise, with the sources read from the project the output directory is
+// in.
+func NewContractSource(absOutDir string) (*ContractSource, error) {
+	report := source.NewLoadReport("foundry", absOutDir)
+	files, err := loadArtifacts(absOutDir, report)
+	if err != nil {
+		return nil, err
+	}
+
+	buildInfos, err := loadBuildInfos(filepath.Join(absOutDir, "build-info"))
+	if err != nil {
+		return nil, err
+	}
+
+	contracts := make(map[string]source.Contract)
+	add := func(truffleContracts []*truffle.Contract, sources []*truffle.SourceUnit) {
+		for code, contract := range truffle.MapContracts(truffleContracts, sources).GetSource().Contracts {
+			contracts[code] = contract
+		}
+	}
+
+	// AST ids are only unique within a compilation, so every build gets an
+	// index of its own.
+	for _, buildInfo := range buildInfos {
+		var truffleContracts []*truffle.Contract
+		var sources []*truffle.SourceUnit
+		truffleContracts, sources, files, err = loadBuild(buildInfo, files, report)
+		if err != nil {
+			return nil, err
+		}
+
+		add(truffleContracts, sources)
+	}
+
+	projectDir := filepath.Dir(absOutDir)
+	var truffleContracts []*truffle.Contract
+	for _, file := range files {
+		truffleContracts = append(truffleContracts, artifactContract(projectDir, file))
+		report.AddLoaded(file.path, "")
+	}
+	add(truffleContracts, nil)
+
+	return &ContractSource{
+		contracts: contracts,
+		report:    report,
+	}, nil
+}
+
+// loadArtifacts reads the artifacts of all contracts with code. Interfaces
+// and abstract contracts have no code to trace.
+func loadArtifacts(absOutDir string, report *source.LoadReport) ([]*contractFile, error) {
+	var files []*contractFile
+	err := filepath.Walk(absOutDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == "build-info" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".json") || strings.HasSuffix(path, ".metadata.json") {
+			return nil
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed reading forge artifact %s, err: %s", path, err)
+		}
+
+		var artifact Artifact
+		err = json.Unmarshal(data, &artifact)
+		if err != nil {
+			report.AddSkipped(path, fmt.Sprintf("failed parsing: %s", err))
+			return nil
+		}
+
+		if len(hexPrefix(artifact.DeployedBytecode.Object)) <= 2 {
+			report.AddSkipped(path, "no deployed code")
+			return nil
+		}
+
+		// Contracts compiled with several compiler versions are written
+		// as <contract name>.<version>.json.
+		name := info.Name()
+		name = name[:strings.Index(name, ".")]
+
+		files = append(files, &contractFile{
+			path:     path,
+			name:     name,
+			artifact: &artifact,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing forge artifacts: %s", err)
+	}
+
+	return files, nil
+}
+
+// loadBuildInfos reads the build info of every compilation, if forge was
+// asked to write them.
+func loadBuildInfos(absBuildInfoDir string) ([]*BuildInfo, error) {
+	files, err := ioutil.ReadDir(absBuildInfoDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed listing forge build info: %s", err)
+	}
+
+	var buildInfos []*BuildInfo
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(absBuildInfoDir, file.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed reading forge build info %s, err: %s", file.Name(), err)
+		}
+
+		var buildInfo BuildInfo
+		err = json.Unmarshal(data, &buildInfo)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing forge build info %s, err: %s", file.Name(), err)
+		}
+
+		buildInfos = append(buildInfos, &buildInfo)
+	}
+
+	return buildInfos, nil
+}
+
+// loadBuild converts the contracts of a compilation which still have their
+// artifacts, and returns them together with all sources of the
+// compilation and the artifacts produced by other compilations. Build info
+// outlives the artifacts of contracts compiled again since, so artifacts are
+// matched by their code as well.
+func loadBuild(buildInfo *BuildInfo, files []*contractFile, report *source.LoadReport) ([]*truffle.Contract, []*truffle.SourceUnit, []*contractFile, error) {
+	compilation := &solc.Compilation{
+		Version: buildInfo.SolcLongVersion,
+		Input:   &buildInfo.Input,
+		Output:  &buildInfo.Output,
+	}
+
+	sources, err := compilation.Sources()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed parsing forge build info %s, err: %s", buildInfo.Id, err)
+	}
+
+	compiled, err := compilation.Contracts()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed parsing forge build info %s, err: %s", buildInfo.Id, err)
+	}
+
+	byKey := make(map[string]*truffle.Contract)
+	for _, contract := range compiled {
+		byKey[contract.SourcePath+":"+contract.Name+":"+contract.DeployedBytecode] = contract
+	}
+
+	var contracts []*truffle.Contract
+	var remaining []*contractFile
+	for _, file := range files {
+		contract, ok := byKey[file.key()]
+		if !ok {
+			remaining = append(remaining, file)
+			continue
+		}
+
+		contracts = append(contracts, contract)
+		report.AddLoaded(file.path, "")
+	}
+
+	return contracts, sources, remaining, nil
+}
+
+// artifactContract converts an artifact on its own. Its source is read from
+// the project, where forge keeps the paths of sources relative to.
+func artifactContract(projectDir string, file *contractFile) *truffle.Contract {
+	artifact := file.artifact
+	contract := &truffle.Contract{
+		Name:                file.name,
+		Abi:                 artifact.Abi,
+		Bytecode:            hexPrefix(artifact.Bytecode.Object),
+		DeployedBytecode:    hexPrefix(artifact.DeployedBytecode.Object),
+		SourceMap:           artifact.Bytecode.SourceMap,
+		DeployedSourceMap:   artifact.DeployedBytecode.SourceMap,
+		StorageLayout:       artifact.StorageLayout,
+		ImmutableReferences: artifact.DeployedBytecode.ImmutableReferences,
+	}
+
+	if artifact.Ast != nil {
+		contract.Ast = *artifact.Ast
+		contract.SourcePath = artifact.Ast.AbsolutePath
+
+		path := artifact.Ast.AbsolutePath
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(projectDir, path)
+		}
+		if content, err := ioutil.ReadFile(path); err == nil {
+			contract.Source = string(content)
+		}
+	}
+
+	return contract
+}
+
+func hexPrefix(code string) string {
+	if strings.HasPrefix(code, "0x") {
+		return code
+	}
+
+	return "0x" + code
+}
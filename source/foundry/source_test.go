@@ -0,0 +1,67 @@
+package foundry
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestContractSource(t *testing.T) {
+	dir, err := filepath.Abs(filepath.Join("testdata", "project", "out"))
+	if err != nil {
+		t.Fatalf("failed resolving output directory: %s", err)
+	}
+
+	cs, err := NewContractSource(dir)
+	if err != nil {
+		t.Fatalf("failed loading contracts: %s", err)
+	}
+	contracts := cs.GetSource()
+
+	// Token comes with its source, read from the project, and the storage
+	// layout forge wrote to its artifact.
+	address := "0x00000000000000000000000000000000000000aa"
+	if name := contracts.GetName(address, "0x6001600055"); name != "Token" {
+		t.Fatalf("expected the code matched to Token, got %q", name)
+	}
+	sourceMap := contracts.GetSourceMap(address, "0x6001600055")
+	for pc, line := range map[int]int{0: 1, 2: 1, 4: 2} {
+		if mapping := sourceMap.At(pc); mapping == nil || mapping.Line != line {
+			t.Errorf("expected the instruction at %d mapped to line %d, got %+v", pc, line, mapping)
+		}
+	}
+	layout := contracts.GetStorageLayout(address, "0x6001600055")
+	if layout == nil || len(layout.Storage) != 1 || layout.Storage[0].Label != "supply" {
+		t.Errorf("expected the storage layout of the artifact, got %+v", layout)
+	}
+
+	// Vault was compiled with several compiler versions and its code lacks
+	// the 0x prefix.
+	if name := contracts.GetName(address, "0x600200"); name != "Vault" {
+		t.Errorf("expected the code matched to Vault, got %q", name)
+	}
+
+	report := cs.Report().String()
+	for _, expected := range []string{
+		"loaded  Token.sol/Token.json\n",
+		"loaded  Vault.sol/Vault.0.8.9.json\n",
+		"skipped Token.sol/IToken.json (no deployed code)",
+	} {
+		if !strings.Contains(report, expected) {
+			t.Errorf("expected %q in the report:\n%s", expected, report)
+		}
+	}
+}
+
+func TestHexPrefix(t *testing.T) {
+	for code, expected := range map[string]string{
+		"":       "0x",
+		"6000":   "0x6000",
+		"0x6000": "0x6000",
+		"0x":     "0x",
+	} {
+		if prefixed := hexPrefix(code); prefixed != expected {
+			t.Errorf("expected %q prefixed as %q, got %q", code, expected, prefixed)
+		}
+	}
+}
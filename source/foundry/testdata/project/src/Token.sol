@@ -0,0 +1,3 @@
+contract Token {
+  function f() {}
+}
\ No newline at end of file
@@ -0,0 +1,37 @@
+package hardhat
+
+import (
+	"github.com/tenderly/tenderly-trace/source"
+	"github.com/tenderly/tenderly-trace/source/solc"
+)
+
+// Artifact is the artifact hardhat writes for every compiled contract, to
+// artifacts/<source path>/<contract name>.json.
+type Artifact struct {
+	Format                 string                                   `json:"_format"`
+	ContractName           string                                   `json:"contractName"`
+	SourceName             string                                   `json:"sourceName"`
+	Abi                    interface{}                              `json:"abi"`
+	Bytecode               string                                   `json:"bytecode"`
+	DeployedBytecode       string                                   `json:"deployedBytecode"`
+	LinkReferences         map[string]map[string][]source.CodeRange `json:"linkReferences"`
+	DeployedLinkReferences map[string]map[string][]source.CodeRange `json:"deployedLinkReferences"`
+}
+
+// DebugFile sits next to every artifact, as <contract name>.dbg.json, and
+// points to the build info of the compilation which produced the artifact.
+type DebugFile struct {
+	Format    string `json:"_format"`
+	BuildInfo string `json:"buildInfo"`
+}
+
+// BuildInfo is the solc standard JSON input and output of a compilation, kept
+// in artifacts/build-info.
+type BuildInfo struct {
+	Format          string      `json:"_format"`
+	Id              string      `json:"id"`
+	SolcVersion     string      `json:"solcVersion"`
+	SolcLongVersion string      `json:"solcLongVersion"`
+	Input           solc.Input  `json:"input"`
+	Output          solc.Output `json:"output"`
+}
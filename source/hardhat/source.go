@@ -0,0 +1,228 @@
+package hardhat
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tenderly/tenderly-trace/source"
+	"github.com/tenderly/tenderly-trace/source/solc"
+	"github.com/tenderly/tenderly-trace/source/truffle"
+)
+
+type ContractSource struct {
+	contracts map[string]source.Contract
+	report    *source.LoadReport
+}
+
+func (cs ContractSource) GetSource() source.ContractSource {
+	contracts := make(map[string]source.Contract)
+	for k, v := range cs.contracts {
+		contracts[k] = v
+	}
+
+	return source.NewContractSource(contracts, nil)
+}
+
+// Report lists the artifacts the Contract Source was built from.
+func (cs ContractSource) Report() *source.LoadReport {
+	return cs.report
+}
+
+// NewContractSource builds the Contract Source from the artifacts directory of
+// a hardhat project. Source maps, ASTs and storage layouts come from the build
+// info of the compilation each artifact was produced by, while artifacts whose
+// build info is gone only provide their bytecode and ABI.
+func NewContractSource(absArtifactsDir string) (*ContractSource, error) {
+	report := source.NewLoadReport("hardhat", absArtifactsDir)
+	artifacts, err := loadArtifacts(absArtifactsDir, report)
+	if err != nil {
+		return nil, err
+	}
+
+	// Artifacts are grouped by their build info, since AST ids are only
+	// unique within a compilation.
+	builds := make(map[string]map[string]*Artifact)
+	for path, artifact := range artifacts {
+		buildInfoPath, err := buildInfoPath(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if builds[buildInfoPath] == nil {
+			builds[buildInfoPath] = make(map[string]*Artifact)
+		}
+		builds[buildInfoPath][path] = artifact
+	}
+
+	contracts := make(map[string]source.Contract)
+	for buildInfoPath, buildArtifacts := range builds {
+		var truffleContracts []*truffle.Contract
+		var sources []*truffle.SourceUnit
+		if buildInfoPath == "" {
+			for path, artifact := range buildArtifacts {
+				truffleContracts = append(truffleContracts, artifactContract(artifact))
+				report.AddLoaded(path, "build info missing, no source maps")
+			}
+		} else {
+			truffleContracts, sources, err = loadBuild(buildInfoPath, buildArtifacts, report)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for code, contract := range truffle.MapContracts(truffleContracts, sources).GetSource().Contracts {
+			contracts[code] = contract
+		}
+	}
+
+	return &ContractSource{
+		contracts: contracts,
+		report:    report,
+	}, nil
+}
+
+// loadArtifacts reads the artifacts of all contracts with code, keyed by their
+// path. Interfaces and abstract contracts have no code to trace.
+func loadArtifacts(absArtifactsDir string, report *source.LoadReport) (map[string]*Artifact, error) {
+	artifacts := make(map[string]*Artifact)
+	err := filepath.Walk(absArtifactsDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == "build-info" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".json") || strings.HasSuffix(path, ".dbg.json") {
+			return nil
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed reading hardhat artifact %s, err: %s", path, err)
+		}
+
+		var artifact Artifact
+		err = json.Unmarshal(data, &artifact)
+		if err != nil {
+			report.AddSkipped(path, fmt.Sprintf("failed parsing: %s", err))
+			return nil
+		}
+
+		switch {
+		case !strings.HasPrefix(artifact.Format, "hh-sol-artifact"):
+			report.AddSkipped(path, "not a hardhat artifact")
+		case len(artifact.DeployedBytecode) <= 2:
+			report.AddSkipped(path, "no deployed code")
+		default:
+			artifacts[path] = &artifact
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing hardhat artifacts: %s", err)
+	}
+
+	return artifacts, nil
+}
+
+// buildInfoPath returns the path of the build info of the artifact, or an
+// empty string if it is missing.
+func buildInfoPath(artifactPath string) (string, error) {
+	debugPath := strings.TrimSuffix(artifactPath, ".json") + ".dbg.json"
+	data, err := ioutil.ReadFile(debugPath)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed reading hardhat debug file %s, err: %s", debugPath, err)
+	}
+
+	var debugFile DebugFile
+	err = json.Unmarshal(data, &debugFile)
+	if err != nil {
+		return "", fmt.Errorf("failed parsing hardhat debug file %s, err: %s", debugPath, err)
+	}
+	if debugFile.BuildInfo == "" {
+		return "", nil
+	}
+
+	path := filepath.Join(filepath.Dir(debugPath), debugFile.BuildInfo)
+	if _, err := os.Stat(path); err != nil {
+		return "", nil
+	}
+
+	return path, nil
+}
+
+// loadBuild converts the artifacts produced by a compilation, using the
+// contracts compiled by it, and returns them together with all sources of the
+// compilation. Build info can hold contracts whose artifacts were since
+// replaced by a later compilation, which are left out.
+func loadBuild(path string, artifacts map[string]*Artifact, report *source.LoadReport) ([]*truffle.Contract, []*truffle.SourceUnit, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed reading hardhat build info %s, err: %s", path, err)
+	}
+
+	var buildInfo BuildInfo
+	err = json.Unmarshal(data, &buildInfo)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed parsing hardhat build info %s, err: %s", path, err)
+	}
+
+	compilation := &solc.Compilation{
+		Version: buildInfo.SolcLongVersion,
+		Input:   &buildInfo.Input,
+		Output:  &buildInfo.Output,
+	}
+
+	sources, err := compilation.Sources()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed parsing hardhat build info %s, err: %s", path, err)
+	}
+
+	compiled, err := compilation.Contracts()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed parsing hardhat build info %s, err: %s", path, err)
+	}
+
+	byName := make(map[string]*truffle.Contract)
+	for _, contract := range compiled {
+		byName[contract.SourcePath+":"+contract.Name] = contract
+	}
+
+	var contracts []*truffle.Contract
+	for artifactPath, artifact := range artifacts {
+		contract, ok := byName[artifact.SourceName+":"+artifact.ContractName]
+		if !ok {
+			contracts = append(contracts, artifactContract(artifact))
+			report.AddLoaded(artifactPath, "missing from its build info, no source maps")
+			continue
+		}
+
+		contracts = append(contracts, contract)
+		report.AddLoaded(artifactPath, "")
+	}
+
+	return contracts, sources, nil
+}
+
+// artifactContract converts an artifact on its own, without source maps and
+// ASTs.
+func artifactContract(artifact *Artifact) *truffle.Contract {
+	return &truffle.Contract{
+		Name:             artifact.ContractName,
+		Abi:              artifact.Abi,
+		Bytecode:         artifact.Bytecode,
+		DeployedBytecode: artifact.DeployedBytecode,
+		SourcePath:       artifact.SourceName,
+	}
+}
@@ -0,0 +1,60 @@
+package hardhat
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// linkedCode is the deployed code of Token in testdata, PUSH20 <library>
+// DELEGATECALL STOP, with the placeholder of its library filled in.
+const linkedCode = "0x73" + "00000000000000000000000000000000000000cc" + "f400"
+
+func TestContractSource(t *testing.T) {
+	dir, err := filepath.Abs(filepath.Join("testdata", "artifacts"))
+	if err != nil {
+		t.Fatalf("failed resolving artifacts directory: %s", err)
+	}
+
+	cs, err := NewContractSource(dir)
+	if err != nil {
+		t.Fatalf("failed loading contracts: %s", err)
+	}
+	contracts := cs.GetSource()
+
+	// Token is joined with its build info through its debug file, so the
+	// code deployed with a linked library comes with a source map and the
+	// storage layout solc reported.
+	if name := contracts.GetName("0x00000000000000000000000000000000000000aa", linkedCode); name != "Token" {
+		t.Fatalf("expected the linked code matched to Token, got %q", name)
+	}
+	sourceMap := contracts.GetSourceMap("0x00000000000000000000000000000000000000aa", linkedCode)
+	for pc, line := range map[int]int{0: 1, 21: 2, 22: 3} {
+		if mapping := sourceMap.At(pc); mapping == nil || mapping.Line != line {
+			t.Errorf("expected the instruction at %d mapped to line %d, got %+v", pc, line, mapping)
+		}
+	}
+	layout := contracts.GetStorageLayout("0x00000000000000000000000000000000000000aa", linkedCode)
+	if layout == nil || len(layout.Storage) != 1 || layout.Storage[0].Label != "supply" {
+		t.Errorf("expected the storage layout of the build info, got %+v", layout)
+	}
+
+	// Old has no debug file and only provides its code.
+	if name := contracts.GetName("0x00000000000000000000000000000000000000bb", "0x600100"); name != "Old" {
+		t.Errorf("expected the code of Old matched without its build info, got %q", name)
+	}
+
+	report := cs.Report().String()
+	for _, expected := range []string{
+		"loaded  contracts/Token.sol/Token.json\n",
+		"loaded  contracts/Old.sol/Old.json (build info missing, no source maps)",
+		"skipped contracts/Token.sol/IToken.json (no deployed code)",
+	} {
+		if !strings.Contains(report, expected) {
+			t.Errorf("expected %q in the report:\n%s", expected, report)
+		}
+	}
+	if len(contracts.Contracts) != 2 {
+		t.Errorf("expected Token and Old, got %d contracts", len(contracts.Contracts))
+	}
+}
@@ -0,0 +1,42 @@
+package source
+
+import "sort"
+
+// LineIndex holds the offsets the lines of a source start at, to turn byte
+// offsets into lines and columns without scanning the source.
+type LineIndex struct {
+	starts []int
+	length int
+}
+
+func NewLineIndex(content string) *LineIndex {
+	index := &LineIndex{
+		starts: []int{0},
+		length: len(content),
+	}
+
+	for i := 0; i < len(content); i++ {
+		if content[i] == '\n' {
+			index.starts = append(index.starts, i+1)
+		}
+	}
+
+	return index
+}
+
+// Position returns the line and column of the byte offset, both counted from
+// one. Offsets past the end of the source are taken for its end.
+func (index *LineIndex) Position(offset int) (int, int) {
+	if offset > index.length {
+		offset = index.length
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	line := sort.Search(len(index.starts), func(i int) bool {
+		return index.starts[i] > offset
+	})
+
+	return line, offset - index.starts[line-1] + 1
+}
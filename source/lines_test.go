@@ -0,0 +1,36 @@
+package source
+
+import "testing"
+
+func TestLineIndexPosition(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		offset  int
+		line    int
+		column  int
+	}{
+		{"first byte", "ab\ncd\n", 0, 1, 1},
+		{"within the first line", "ab\ncd\n", 1, 1, 2},
+		{"newline ends its line", "ab\ncd\n", 2, 1, 3},
+		{"start of the second line", "ab\ncd\n", 3, 2, 1},
+		{"end after a trailing newline", "ab\ncd\n", 6, 3, 1},
+		{"empty line", "ab\n\ncd", 3, 2, 1},
+		{"carriage return counts as a column", "a\r\nbc\r\n", 1, 1, 2},
+		{"line after a CRLF", "a\r\nbc\r\n", 3, 2, 1},
+		{"carriage return of the second line", "a\r\nbc\r\n", 5, 2, 3},
+		{"last line without a newline", "ab\ncd", 4, 2, 2},
+		{"end of a last line without a newline", "ab\ncd", 5, 2, 3},
+		{"past the end", "ab\ncd", 50, 2, 3},
+		{"negative offset", "ab\ncd", -1, 1, 1},
+		{"empty source", "", 0, 1, 1},
+		{"past the end of an empty source", "", 3, 1, 1},
+	}
+
+	for _, test := range tests {
+		line, column := NewLineIndex(test.content).Position(test.offset)
+		if line != test.line || column != test.column {
+			t.Errorf("%s: expected offset %d at %d:%d, got %d:%d", test.name, test.offset, test.line, test.column, line, column)
+		}
+	}
+}
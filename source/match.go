@@ -0,0 +1,188 @@
+package source
+
+import (
+	"encoding/hex"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// CodeRange is a range of bytes of deployed code.
+type CodeRange struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+// codePattern is the deployed code of a contract, without its metadata, with
+// the bytes which differ between deployments masked out.
+type codePattern struct {
+	code     []byte
+	mask     []bool
+	contract Contract
+}
+
+// codeIndex matches deployed code to the contracts it was compiled from. It is
+// built the first time code has to be matched, and remembers every match.
+type codeIndex struct {
+	once      sync.Once
+	contracts map[string]Contract
+	patterns  map[int][]*codePattern
+
+	lock    sync.Mutex
+	matches map[string]Contract
+}
+
+func newCodeIndex(contracts map[string]Contract) *codeIndex {
+	return &codeIndex{
+		contracts: contracts,
+		matches:   make(map[string]Contract),
+	}
+}
+
+func (index *codeIndex) build() {
+	index.patterns = make(map[int][]*codePattern)
+
+	// Contracts are visited in order of their code, so the same code
+	// matches the same contract every time.
+	var keys []string
+	for key := range index.contracts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		contract := index.contracts[key]
+		pattern, ok := newCodePattern(contract.GetContractDeployedBytecode(), contract.GetContractImmutableReferences())
+		if !ok || len(pattern.code) == 0 {
+			continue
+		}
+
+		pattern.contract = contract
+		index.patterns[len(pattern.code)] = append(index.patterns[len(pattern.code)], pattern)
+	}
+}
+
+// match returns the contract whose code matches the deployed code, or nil if
+// there is none.
+func (index *codeIndex) match(code string) Contract {
+	index.once.Do(index.build)
+
+	index.lock.Lock()
+	defer index.lock.Unlock()
+
+	if contract, ok := index.matches[code]; ok {
+		return contract
+	}
+
+	var match Contract
+	deployed, err := hex.DecodeString(strings.TrimPrefix(code, "0x"))
+	if err == nil {
+		deployed = deployed[:len(deployed)-metadataLength(deployed)]
+		for _, pattern := range index.patterns[len(deployed)] {
+			if pattern.matches(deployed) {
+				match = pattern.contract
+				break
+			}
+		}
+	}
+
+	index.matches[code] = match
+	return match
+}
+
+func (p *codePattern) matches(code []byte) bool {
+	for i, b := range code {
+		if !p.mask[i] && p.code[i] != b {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newCodePattern decodes the deployed code of a compiled contract. Masked out
+// are the placeholders of libraries which weren't linked yet, the immutables,
+// and the address libraries guard against being called directly with, which
+// is filled in when they are deployed.
+func newCodePattern(hexCode string, immutables []CodeRange) (*codePattern, bool) {
+	hexCode = strings.TrimPrefix(hexCode, "0x")
+	if len(hexCode)%2 != 0 {
+		return nil, false
+	}
+
+	code := make([]byte, len(hexCode)/2)
+	mask := make([]bool, len(code))
+	for i := 0; i < len(code); i++ {
+		// Placeholders take the 20 bytes of an address, like __Library__
+		// padded with underscores or __$hash$__.
+		if hexCode[2*i] == '_' {
+			if 2*i+40 > len(hexCode) {
+				return nil, false
+			}
+			for j := i; j < i+20; j++ {
+				mask[j] = true
+			}
+			i += 19
+			continue
+		}
+
+		b, err := hex.DecodeString(hexCode[2*i : 2*i+2])
+		if err != nil {
+			return nil, false
+		}
+		code[i] = b[0]
+	}
+
+	for _, immutable := range immutables {
+		for j := immutable.Start; j < immutable.Start+immutable.Length && j < len(code); j++ {
+			if j >= 0 {
+				mask[j] = true
+			}
+		}
+	}
+
+	// Libraries start by pushing the address they are deployed at, which
+	// is all zeros in the compiled code.
+	if len(code) > 21 && code[0] == 0x73 && isZero(code[1:21]) {
+		for j := 1; j < 21; j++ {
+			mask[j] = true
+		}
+	}
+
+	length := len(code) - metadataLength(code)
+	return &codePattern{
+		code: code[:length],
+		mask: mask[:length],
+	}, true
+}
+
+// metadataLength returns the length of the CBOR encoded metadata the compiler
+// appends to the code, followed by its length in two bytes, or zero if the
+// code doesn't end with it.
+func metadataLength(code []byte) int {
+	if len(code) < 2 {
+		return 0
+	}
+
+	length := int(code[len(code)-2])<<8 | int(code[len(code)-1])
+	if length == 0 || length+2 > len(code) {
+		return 0
+	}
+
+	// The metadata is a CBOR map with a handful of entries.
+	if header := code[len(code)-2-length]; header < 0xa1 || header > 0xa7 {
+		return 0
+	}
+
+	return length + 2
+}
+
+func isZero(data []byte) bool {
+	for _, b := range data {
+		if b != 0 {
+			return false
+		}
+	}
+
+	return true
+}
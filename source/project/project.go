@@ -0,0 +1,230 @@
+package project
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tenderly/tenderly-trace/source"
+	"github.com/tenderly/tenderly-trace/source/brownie"
+	"github.com/tenderly/tenderly-trace/source/foundry"
+	"github.com/tenderly/tenderly-trace/source/hardhat"
+	"github.com/tenderly/tenderly-trace/source/truffle"
+)
+
+type Framework string
+
+const (
+	Truffle Framework = "truffle"
+	Hardhat Framework = "hardhat"
+	Foundry Framework = "foundry"
+	Brownie Framework = "brownie"
+)
+
+// Project is a smart contract project, with the contract source built from
+// the artifacts of its framework.
+type Project struct {
+	Root         string
+	Framework    Framework
+	ArtifactsDir string
+
+	Source source.Source
+	Report *source.LoadReport
+}
+
+// Load detects the framework of the project at the given root and builds the
+// contract source from its artifacts. Truffle artifacts record the addresses
+// contracts are deployed at, which are bound on the network with the given id.
+func Load(root string, networkID string) (*Project, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed resolving project root: %s", err)
+	}
+
+	framework, artifactsDir, err := Detect(root)
+	if err != nil {
+		return nil, err
+	}
+
+	project := &Project{
+		Root:         root,
+		Framework:    framework,
+		ArtifactsDir: artifactsDir,
+	}
+
+	switch framework {
+	case Truffle:
+		contractSource, err := truffle.NewContractSource(artifactsDir, networkID)
+		if err != nil {
+			return nil, err
+		}
+		project.Source, project.Report = contractSource, contractSource.Report()
+	case Hardhat:
+		contractSource, err := hardhat.NewContractSource(artifactsDir)
+		if err != nil {
+			return nil, err
+		}
+		project.Source, project.Report = contractSource, contractSource.Report()
+	case Foundry:
+		contractSource, err := foundry.NewContractSource(artifactsDir)
+		if err != nil {
+			return nil, err
+		}
+		project.Source, project.Report = contractSource, contractSource.Report()
+	case Brownie:
+		contractSource, err := brownie.NewContractSource(artifactsDir)
+		if err != nil {
+			return nil, err
+		}
+		project.Source, project.Report = contractSource, contractSource.Report()
+	}
+
+	return project, nil
+}
+
+// Detect tells the framework of the project at the given root, together with
+// the directory it writes its artifacts to. The configuration file of the
+// framework decides, and the artifacts found in the project otherwise. A
+// directory named out only counts as foundry's when it holds forge artifacts,
+// as plenty of tools write to one.
+func Detect(root string) (Framework, string, error) {
+	switch {
+	case exists(root, "foundry.toml"):
+		return Foundry, filepath.Join(root, foundryOutDir(root)), nil
+	case exists(root, "hardhat.config.js", "hardhat.config.ts", "hardhat.config.cjs", "hardhat.config.mjs"):
+		return Hardhat, filepath.Join(root, "artifacts"), nil
+	case exists(root, "brownie-config.yaml", "brownie-config.yml"):
+		return Brownie, filepath.Join(root, "build", "contracts"), nil
+	case exists(root, "truffle-config.js", "truffle.js"):
+		return Truffle, filepath.Join(root, "build", "contracts"), nil
+	}
+
+	buildDir := filepath.Join(root, "build", "contracts")
+	switch {
+	case exists(root, filepath.Join("artifacts", "build-info")):
+		return Hardhat, filepath.Join(root, "artifacts"), nil
+	case isForgeOutput(filepath.Join(root, "out")):
+		return Foundry, filepath.Join(root, "out"), nil
+	case exists(root, filepath.Join("build", "contracts")):
+		if isBrownieBuild(buildDir) {
+			return Brownie, buildDir, nil
+		}
+		return Truffle, buildDir, nil
+	}
+
+	return "", "", fmt.Errorf("no truffle, hardhat, foundry or brownie project found in %s", root)
+}
+
+// foundryOutDir reads the output directory of the default profile from the
+// foundry configuration, which is out unless set otherwise.
+func foundryOutDir(root string) string {
+	file, err := os.Open(filepath.Join(root, "foundry.toml"))
+	if err != nil {
+		return "out"
+	}
+	defer file.Close()
+
+	profile := ""
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") {
+			profile = strings.Trim(line, "[] ")
+			continue
+		}
+		if profile != "profile.default" && profile != "default" {
+			continue
+		}
+
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) == 2 && strings.TrimSpace(parts[0]) == "out" {
+			return strings.Trim(strings.TrimSpace(parts[1]), `"'`)
+		}
+	}
+
+	return "out"
+}
+
+// isBrownieBuild tells brownie artifacts apart from truffle ones, which are
+// kept in the same directory, by the paths of all sources only brownie keeps.
+func isBrownieBuild(buildDir string) bool {
+	files, err := ioutil.ReadDir(buildDir)
+	if err != nil {
+		return false
+	}
+
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(buildDir, file.Name()))
+		if err != nil {
+			continue
+		}
+
+		var artifact struct {
+			AllSourcePaths map[string]string `json:"allSourcePaths"`
+		}
+		if json.Unmarshal(data, &artifact) == nil {
+			return artifact.AllSourcePaths != nil
+		}
+	}
+
+	return false
+}
+
+// isForgeOutput tells whether the directory holds forge artifacts, which are
+// kept in a directory per source file and, unlike the ones of other
+// frameworks, have their deployed bytecode as an object.
+func isForgeOutput(outDir string) bool {
+	dirs, err := ioutil.ReadDir(outDir)
+	if err != nil {
+		return false
+	}
+
+	for _, dir := range dirs {
+		if !dir.IsDir() || dir.Name() == "build-info" {
+			continue
+		}
+
+		files, err := ioutil.ReadDir(filepath.Join(outDir, dir.Name()))
+		if err != nil {
+			continue
+		}
+
+		for _, file := range files {
+			if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") || strings.HasSuffix(file.Name(), ".metadata.json") {
+				continue
+			}
+
+			data, err := ioutil.ReadFile(filepath.Join(outDir, dir.Name(), file.Name()))
+			if err != nil {
+				continue
+			}
+
+			var artifact struct {
+				DeployedBytecode json.RawMessage `json:"deployedBytecode"`
+			}
+			if json.Unmarshal(data, &artifact) == nil && strings.HasPrefix(string(artifact.DeployedBytecode), "{") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func exists(root string, names ...string) bool {
+	for _, name := range names {
+		if _, err := os.Stat(filepath.Join(root, name)); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
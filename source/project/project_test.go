@@ -0,0 +1,151 @@
+package project
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const (
+	forgeArtifact   = `{"abi": [], "deployedBytecode": {"object": "0x600100", "sourceMap": ""}}`
+	truffleArtifact = `{"contractName": "Token", "abi": [], "deployedBytecode": "0x600100"}`
+	brownieArtifact = `{"contractName": "Token", "abi": [], "deployedBytecode": "600100", "allSourcePaths": {"0": "contracts/Token.sol"}}`
+)
+
+// writeProject creates a project holding the given files, keyed by their
+// path relative to the project root.
+func writeProject(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	root, err := ioutil.TempDir("", "project")
+	if err != nil {
+		t.Fatalf("failed creating project: %s", err)
+	}
+
+	for path, content := range files {
+		path = filepath.Join(root, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed creating directory: %s", err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed writing %s: %s", path, err)
+		}
+	}
+
+	return root
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name         string
+		files        map[string]string
+		framework    Framework
+		artifactsDir string
+	}{
+		{
+			name: "foundry configuration first",
+			files: map[string]string{
+				"foundry.toml":      "[profile.default]\nout = \"forge-out\"\n",
+				"hardhat.config.js": "",
+			},
+			framework:    Foundry,
+			artifactsDir: "forge-out",
+		},
+		{
+			name:         "hardhat configuration",
+			files:        map[string]string{"hardhat.config.ts": "", "truffle-config.js": ""},
+			framework:    Hardhat,
+			artifactsDir: "artifacts",
+		},
+		{
+			name:         "brownie configuration",
+			files:        map[string]string{"brownie-config.yaml": "", "truffle-config.js": ""},
+			framework:    Brownie,
+			artifactsDir: "build/contracts",
+		},
+		{
+			name:         "truffle configuration",
+			files:        map[string]string{"truffle.js": ""},
+			framework:    Truffle,
+			artifactsDir: "build/contracts",
+		},
+		{
+			name: "hardhat build info",
+			files: map[string]string{
+				"artifacts/build-info/1.json": "{}",
+				"out/Token.sol/Token.json":    forgeArtifact,
+			},
+			framework:    Hardhat,
+			artifactsDir: "artifacts",
+		},
+		{
+			name:         "forge artifacts",
+			files:        map[string]string{"out/Token.sol/Token.json": forgeArtifact},
+			framework:    Foundry,
+			artifactsDir: "out",
+		},
+		{
+			name: "out without forge artifacts",
+			files: map[string]string{
+				"out/coverage/report.json":   `{"lines": 10}`,
+				"out/Token.sol/Token.json":   truffleArtifact,
+				"build/contracts/Token.json": truffleArtifact,
+			},
+			framework:    Truffle,
+			artifactsDir: "build/contracts",
+		},
+		{
+			name:         "brownie artifacts",
+			files:        map[string]string{"build/contracts/Token.json": brownieArtifact},
+			framework:    Brownie,
+			artifactsDir: "build/contracts",
+		},
+	}
+
+	for _, test := range tests {
+		root := writeProject(t, test.files)
+
+		framework, artifactsDir, err := Detect(root)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+		} else if framework != test.framework || artifactsDir != filepath.Join(root, filepath.FromSlash(test.artifactsDir)) {
+			t.Errorf("%s: expected %s artifacts in %s, got %s artifacts in %s", test.name, test.framework, test.artifactsDir, framework, artifactsDir)
+		}
+
+		os.RemoveAll(root)
+	}
+}
+
+func TestDetectNoProject(t *testing.T) {
+	root := writeProject(t, map[string]string{"out/notes.txt": "", "README.md": ""})
+	defer os.RemoveAll(root)
+
+	if framework, _, err := Detect(root); err == nil {
+		t.Errorf("expected no project found, got %s", framework)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	root := writeProject(t, map[string]string{
+		"foundry.toml":              "[profile.default]\nsrc = \"src\"\n",
+		"out/Token.sol/Token.json":  forgeArtifact,
+		"out/Token.sol/IToken.json": `{"abi": [], "deployedBytecode": {"object": "0x"}}`,
+	})
+	defer os.RemoveAll(root)
+
+	project, err := Load(root, "1")
+	if err != nil {
+		t.Fatalf("failed loading project: %s", err)
+	}
+
+	if project.Root != root || project.Framework != Foundry || project.ArtifactsDir != filepath.Join(root, "out") {
+		t.Errorf("expected the foundry project at %s, got %s artifacts in %s", root, project.Framework, project.ArtifactsDir)
+	}
+	if len(project.Report.Loaded) != 1 || len(project.Report.Skipped) != 1 {
+		t.Errorf("expected one artifact loaded and one skipped, got %d and %d", len(project.Report.Loaded), len(project.Report.Skipped))
+	}
+	if name := project.Source.GetSource().GetName("0x00000000000000000000000000000000000000aa", "0x600100"); name != "Token" {
+		t.Errorf("expected the code matched to Token, got %q", name)
+	}
+}
@@ -0,0 +1,80 @@
+package source
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ReportEntry is an artifact a contract source came across while loading,
+// with a note on why it was skipped or what it lacks.
+type ReportEntry struct {
+	Path string
+	Note string
+}
+
+// LoadReport lists the artifacts a contract source was built from and the
+// ones it skipped.
+type LoadReport struct {
+	Framework string
+	Dir       string
+	Loaded    []ReportEntry
+	Skipped   []ReportEntry
+}
+
+func NewLoadReport(framework string, dir string) *LoadReport {
+	return &LoadReport{
+		Framework: framework,
+		Dir:       dir,
+	}
+}
+
+// AddLoaded records a loaded artifact. The note tells what it lacks, if
+// anything, like source maps.
+func (r *LoadReport) AddLoaded(path string, note string) {
+	r.Loaded = append(r.Loaded, ReportEntry{Path: r.relative(path), Note: note})
+}
+
+// AddSkipped records an artifact which was skipped for the given reason.
+func (r *LoadReport) AddSkipped(path string, reason string) {
+	r.Skipped = append(r.Skipped, ReportEntry{Path: r.relative(path), Note: reason})
+}
+
+func (r *LoadReport) relative(path string) string {
+	if rel, err := filepath.Rel(r.Dir, path); err == nil && !strings.HasPrefix(rel, "..") {
+		return rel
+	}
+
+	return path
+}
+
+func (r *LoadReport) String() string {
+	sortEntries(r.Loaded)
+	sortEntries(r.Skipped)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: loaded %d artifacts from %s, skipped %d\n", r.Framework, len(r.Loaded), r.Dir, len(r.Skipped))
+	for _, entry := range r.Loaded {
+		writeEntry(&b, "loaded", entry)
+	}
+	for _, entry := range r.Skipped {
+		writeEntry(&b, "skipped", entry)
+	}
+
+	return b.String()
+}
+
+func writeEntry(b *strings.Builder, status string, entry ReportEntry) {
+	if entry.Note == "" {
+		fmt.Fprintf(b, "  %-7s %s\n", status, entry.Path)
+		return
+	}
+	fmt.Fprintf(b, "  %-7s %s (%s)\n", status, entry.Path, entry.Note)
+}
+
+func sortEntries(entries []ReportEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Path < entries[j].Path
+	})
+}
@@ -0,0 +1,141 @@
+package solc
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/tenderly/tenderly-trace/source/truffle"
+)
+
+// Compilation is a run of the Solidity compiler, its standard JSON input
+// together with the output produced for it. The input may be missing, in
+// which case the artifacts come without the content of their sources.
+type Compilation struct {
+	Version string
+	Input   *Input
+	Output  *Output
+
+	sources []*truffle.SourceUnit
+}
+
+// Sources returns all source units of the compilation with their parsed ASTs,
+// ordered by their id, which is the file index source maps refer to them by.
+func (c *Compilation) Sources() ([]*truffle.SourceUnit, error) {
+	if c.sources != nil {
+		return c.sources, nil
+	}
+
+	var sources []*truffle.SourceUnit
+	for path, outputSource := range c.Output.Sources {
+		unit := &truffle.SourceUnit{
+			Id:   outputSource.Id,
+			Path: path,
+		}
+
+		if c.Input != nil {
+			unit.Content = c.Input.Sources[path].Content
+		}
+
+		if len(outputSource.Ast) != 0 {
+			var sourceAst truffle.ContractAst
+			err := json.Unmarshal(outputSource.Ast, &sourceAst)
+			if err != nil {
+				return nil, fmt.Errorf("failed parsing ast of %s, err: %s", path, err)
+			}
+			unit.Ast = &sourceAst
+		}
+
+		sources = append(sources, unit)
+	}
+	sort.Slice(sources, func(i, j int) bool {
+		return sources[i].Id < sources[j].Id
+	})
+	c.sources = sources
+
+	return sources, nil
+}
+
+// Contracts converts every compiled contract with deployed code to a truffle
+// artifact, which carries the AST and content of the source the contract is
+// declared in. Contracts are ordered by source path and name.
+func (c *Compilation) Contracts() ([]*truffle.Contract, error) {
+	sources, err := c.Sources()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.contracts(sources), nil
+}
+
+// ContractSource builds the Contract Source of all contracts of the
+// compilation.
+func (c *Compilation) ContractSource() (*truffle.ContractSource, error) {
+	sources, err := c.Sources()
+	if err != nil {
+		return nil, err
+	}
+
+	return truffle.MapContracts(c.contracts(sources), sources), nil
+}
+
+func (c *Compilation) contracts(sources []*truffle.SourceUnit) []*truffle.Contract {
+	units := make(map[string]*truffle.SourceUnit)
+	for _, unit := range sources {
+		units[unit.Path] = unit
+	}
+
+	var paths []string
+	for path := range c.Output.Contracts {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var contracts []*truffle.Contract
+	for _, path := range paths {
+		var names []string
+		for name := range c.Output.Contracts[path] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			// Interfaces and abstract contracts have no code to trace.
+			compiled := c.Output.Contracts[path][name]
+			if compiled.Evm.DeployedBytecode.Object == "" {
+				continue
+			}
+
+			contracts = append(contracts, c.contract(path, name, compiled, units[path]))
+		}
+	}
+
+	return contracts
+}
+
+func (c *Compilation) contract(path string, name string, compiled OutputContract, unit *truffle.SourceUnit) *truffle.Contract {
+	contract := &truffle.Contract{
+		Name:                name,
+		Abi:                 compiled.Abi,
+		Bytecode:            "0x" + compiled.Evm.Bytecode.Object,
+		DeployedBytecode:    "0x" + compiled.Evm.DeployedBytecode.Object,
+		SourceMap:           compiled.Evm.Bytecode.SourceMap,
+		DeployedSourceMap:   compiled.Evm.DeployedBytecode.SourceMap,
+		SourcePath:          path,
+		StorageLayout:       compiled.StorageLayout,
+		ImmutableReferences: compiled.Evm.DeployedBytecode.ImmutableReferences,
+		Compiler: truffle.ContractCompiler{
+			Name:    "solc",
+			Version: c.Version,
+		},
+	}
+
+	if unit != nil {
+		contract.Source = unit.Content
+		if unit.Ast != nil {
+			contract.Ast = *unit.Ast
+		}
+	}
+
+	return contract
+}
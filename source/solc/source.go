@@ -0,0 +1,104 @@
+package solc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/tenderly/tenderly-trace/source/truffle"
+)
+
+// Metadata is the metadata the Solidity compiler embeds the hash of in the
+// code, as published with verified contracts. Its sources come with their
+// content or are kept in files next to it.
+type Metadata struct {
+	Compiler struct {
+		Version string `json:"version"`
+	} `json:"compiler"`
+	Language string                 `json:"language"`
+	Sources  map[string]InputSource `json:"sources"`
+	Settings json.RawMessage        `json:"settings"`
+}
+
+// NewContractSource builds the Contract Source of every contract compiled to
+// the given standard JSON output. The input, which provides the content of
+// the sources, is either the standard JSON input of the compilation or its
+// metadata, and may be left out.
+func NewContractSource(absOutputPath string, absInputPath string) (*truffle.ContractSource, error) {
+	data, err := ioutil.ReadFile(absOutputPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading solc output: %s", err)
+	}
+
+	var output Output
+	err = json.Unmarshal(data, &output)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing solc output: %s", err)
+	}
+
+	for _, outputError := range output.Errors {
+		if outputError.Severity == "error" {
+			return nil, fmt.Errorf("failed compilation: %s", outputError.FormattedMessage)
+		}
+	}
+
+	compilation := &Compilation{
+		Output: &output,
+	}
+
+	if absInputPath != "" {
+		compilation.Input, compilation.Version, err = loadInput(absInputPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return compilation.ContractSource()
+}
+
+// loadInput reads the standard JSON input or the metadata of a compilation,
+// together with the compiler version if the metadata tells it.
+func loadInput(absInputPath string) (*Input, string, error) {
+	data, err := ioutil.ReadFile(absInputPath)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed reading solc input: %s", err)
+	}
+
+	var metadata Metadata
+	err = json.Unmarshal(data, &metadata)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed parsing solc input: %s", err)
+	}
+
+	input := &Input{
+		Language: metadata.Language,
+		Sources:  metadata.Sources,
+		Settings: metadata.Settings,
+	}
+
+	if metadata.Compiler.Version == "" {
+		return input, "", nil
+	}
+
+	// Verified sources are published as sources/<path> next to the
+	// metadata, when they aren't embedded in it.
+	dir := filepath.Dir(absInputPath)
+	for path, inputSource := range input.Sources {
+		if inputSource.Content != "" {
+			continue
+		}
+
+		for _, candidate := range []string{filepath.Join(dir, "sources", path), filepath.Join(dir, path)} {
+			content, err := ioutil.ReadFile(candidate)
+			if err == nil {
+				inputSource.Content = string(content)
+				break
+			}
+		}
+
+		input.Sources[path] = inputSource
+	}
+
+	return input, metadata.Compiler.Version, nil
+}
@@ -0,0 +1,61 @@
+package solc
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// testOutput is the standard JSON output of a source declaring an interface
+// and a contract implementing it.
+const testOutput = `{
+	"sources": {"Token.sol": {"id": 0}},
+	"contracts": {
+		"Token.sol": {
+			"IToken": {
+				"abi": [{"type": "function", "name": "f", "inputs": [], "outputs": [], "stateMutability": "nonpayable"}],
+				"evm": {"bytecode": {"object": ""}, "deployedBytecode": {"object": ""}}
+			},
+			"Token": {
+				"abi": [{"type": "function", "name": "f", "inputs": [], "outputs": [], "stateMutability": "nonpayable"}],
+				"evm": {
+					"bytecode": {"object": "6001600055", "sourceMap": "0:10:0"},
+					"deployedBytecode": {"object": "600000", "sourceMap": "0:10:0;;"}
+				}
+			}
+		}
+	}
+}`
+
+// Interfaces have no deployed code and are left out, so code nobody compiled
+// isn't attributed to them.
+func TestContractSourceSkipsInterfaces(t *testing.T) {
+	dir, err := ioutil.TempDir("", "solc")
+	if err != nil {
+		t.Fatalf("failed creating output directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "output.json")
+	err = ioutil.WriteFile(path, []byte(testOutput), 0644)
+	if err != nil {
+		t.Fatalf("failed writing output: %s", err)
+	}
+
+	cs, err := NewContractSource(path, "")
+	if err != nil {
+		t.Fatalf("failed loading contracts: %s", err)
+	}
+	contracts := cs.GetSource()
+
+	if len(contracts.Contracts) != 1 {
+		t.Fatalf("expected only the contract with code, got %d contracts", len(contracts.Contracts))
+	}
+	if name := contracts.GetName("0x00000000000000000000000000000000000000aa", "0x600000"); name != "Token" {
+		t.Errorf("expected the deployed code mapped to Token, got %q", name)
+	}
+	if contract := contracts.GetContract("0x00000000000000000000000000000000000000bb", "0x"); contract != nil {
+		t.Errorf("expected an account without code not mapped, got %s", contract.GetContractName())
+	}
+}
@@ -0,0 +1,63 @@
+package solc
+
+import (
+	"encoding/json"
+
+	"github.com/tenderly/tenderly-trace/ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/source"
+)
+
+// Input is the standard JSON input of the Solidity compiler. Only the parts
+// needed to trace contracts are kept.
+type Input struct {
+	Language string                 `json:"language"`
+	Sources  map[string]InputSource `json:"sources"`
+	Settings json.RawMessage        `json:"settings"`
+}
+
+type InputSource struct {
+	Content string   `json:"content"`
+	Urls    []string `json:"urls"`
+}
+
+// Output is the standard JSON output of the Solidity compiler.
+type Output struct {
+	Errors    []OutputError                        `json:"errors"`
+	Sources   map[string]OutputSource              `json:"sources"`
+	Contracts map[string]map[string]OutputContract `json:"contracts"`
+}
+
+type OutputError struct {
+	Severity         string `json:"severity"`
+	Type             string `json:"type"`
+	FormattedMessage string `json:"formattedMessage"`
+}
+
+// OutputSource is a source unit of the compilation. Its id is the file index
+// used by source maps.
+type OutputSource struct {
+	Id  int             `json:"id"`
+	Ast json.RawMessage `json:"ast"`
+}
+
+type OutputContract struct {
+	Abi           interface{}          `json:"abi"`
+	Evm           Evm                  `json:"evm"`
+	StorageLayout *types.StorageLayout `json:"storageLayout"`
+	Metadata      string               `json:"metadata"`
+}
+
+type Evm struct {
+	Bytecode          Bytecode          `json:"bytecode"`
+	DeployedBytecode  Bytecode          `json:"deployedBytecode"`
+	MethodIdentifiers map[string]string `json:"methodIdentifiers"`
+}
+
+// Bytecode is compiled code, hex encoded without a 0x prefix, together with
+// its source map and the places where libraries and immutables are filled in.
+type Bytecode struct {
+	Object              string                                   `json:"object"`
+	SourceMap           string                                   `json:"sourceMap"`
+	LinkReferences      map[string]map[string][]source.CodeRange `json:"linkReferences"`
+	ImmutableReferences map[string][]source.CodeRange            `json:"immutableReferences"`
+}
@@ -1,7 +1,10 @@
 package source
 
 import (
+	"strings"
+
 	"github.com/tenderly/tenderly-trace/ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum/signer/accounts/abi"
 )
 
 type InstructionMapping struct {
@@ -14,26 +17,96 @@ type InstructionMapping struct {
 	Line   int
 	Column int
 
-	FileIndex int
-	Jump      string
+	// File is the path of the source the instruction maps into, and is
+	// empty when the source isn't known. Generated marks instructions of
+	// code the compiler generated, which map into no source or into one of
+	// the compiler's own. ModifierDepth is how deep into modifiers of the
+	// function the instruction is.
+	File          string
+	FileIndex     int
+	Generated     bool
+	Jump          string
+	ModifierDepth int
+
+	// Text is the first line of the source code the instruction maps to.
+	Text string
 }
 
 type Ast map[int]*types.Node
-type SourceMap map[int]*InstructionMapping
+
+// SourceMap maps the offsets of instructions in the deployed code to their
+// mappings. Instructions mapping to the same source range share the mapping,
+// which carries the index of the first of them.
+type SourceMap []*InstructionMapping
+
+// At returns the mapping of the instruction at the code offset, or nil if it
+// doesn't have one.
+func (sm SourceMap) At(pc int) *InstructionMapping {
+	if pc < 0 || pc >= len(sm) {
+		return nil
+	}
+
+	return sm[pc]
+}
 
 type Contract interface {
+	GetContractName() string
 	GetContractAst(sourceMap SourceMap) types.Ast
 	GetContractStateVariables() []*types.Node
+	GetContractDefinitions() types.Definitions
+	GetContractStorageLayout() *types.StorageLayout
+	GetContractAbi() *abi.ABI
 	GetContractSourceMap() (SourceMap, error)
+	GetContractDeployedBytecode() string
+	GetContractImmutableReferences() []CodeRange
 }
 
 type ContractSource struct {
 	Contracts map[string]Contract //mapping code => contract interface
+	// Addresses binds the addresses contracts are deployed at to them,
+	// which takes precedence over matching their code.
+	Addresses map[string]Contract
+
+	index *codeIndex
 }
 
-func (cs ContractSource) GetAst(code string) types.Ast {
-	contractCode, ok := cs.Contracts[code]
-	if !ok {
+// NewContractSource builds the Contract Source of the contracts, keyed by
+// their deployed code, and the deployed addresses bound to them. Code which
+// isn't found as is gets matched to the contracts regardless of parts which
+// differ between deployments, like the metadata hash, linked libraries and
+// immutables.
+func NewContractSource(contracts map[string]Contract, addresses map[string]Contract) ContractSource {
+	bound := make(map[string]Contract)
+	for address, contract := range addresses {
+		bound[strings.ToLower(address)] = contract
+	}
+
+	return ContractSource{
+		Contracts: contracts,
+		Addresses: bound,
+		index:     newCodeIndex(contracts),
+	}
+}
+
+// GetContract returns the contract deployed at the address with the given
+// code, or nil if there is none.
+func (cs ContractSource) GetContract(address string, code string) Contract {
+	if contract, ok := cs.Addresses[strings.ToLower(address)]; ok {
+		return contract
+	}
+	if contract, ok := cs.Contracts[code]; ok {
+		return contract
+	}
+	if cs.index == nil {
+		return nil
+	}
+
+	return cs.index.match(code)
+}
+
+func (cs ContractSource) GetAst(address string, code string) types.Ast {
+	contractCode := cs.GetContract(address, code)
+	if contractCode == nil {
 		return nil
 	}
 
@@ -45,15 +118,65 @@ func (cs ContractSource) GetAst(code string) types.Ast {
 	return contractCode.GetContractAst(sourceMap)
 }
 
-func (cs ContractSource) GetStateVariables(code string) []*types.Node {
-	contractCode, ok := cs.Contracts[code]
-	if !ok {
+func (cs ContractSource) GetStateVariables(address string, code string) []*types.Node {
+	contractCode := cs.GetContract(address, code)
+	if contractCode == nil {
 		return nil
 	}
 
 	return contractCode.GetContractStateVariables()
 }
 
+func (cs ContractSource) GetDefinitions(address string, code string) types.Definitions {
+	contractCode := cs.GetContract(address, code)
+	if contractCode == nil {
+		return nil
+	}
+
+	return contractCode.GetContractDefinitions()
+}
+
+func (cs ContractSource) GetStorageLayout(address string, code string) *types.StorageLayout {
+	contractCode := cs.GetContract(address, code)
+	if contractCode == nil {
+		return nil
+	}
+
+	return contractCode.GetContractStorageLayout()
+}
+
+func (cs ContractSource) GetAbi(address string, code string) *abi.ABI {
+	contractCode := cs.GetContract(address, code)
+	if contractCode == nil {
+		return nil
+	}
+
+	return contractCode.GetContractAbi()
+}
+
+func (cs ContractSource) GetName(address string, code string) string {
+	contractCode := cs.GetContract(address, code)
+	if contractCode == nil {
+		return ""
+	}
+
+	return contractCode.GetContractName()
+}
+
+func (cs ContractSource) GetSourceMap(address string, code string) SourceMap {
+	contractCode := cs.GetContract(address, code)
+	if contractCode == nil {
+		return nil
+	}
+
+	sourceMap, err := contractCode.GetContractSourceMap()
+	if err != nil {
+		return nil
+	}
+
+	return sourceMap
+}
+
 //func (cs ContractSource) GetAst(code string) *state.Variables {
 //	contract := cs.Contracts[code]
 //	if contract == nil {
@@ -126,16 +249,3 @@ type Source interface {
 //		DeployedBytecode: *deployedBytecode,
 //	}
 //}
-
-//func (cs ContractSource) DecodeInput(address string, rawInput hexutil.Bytes) *[]core.DecodedArgument {
-//	c := cs.GetContract(address)
-//	if c.GetAbi() != nil {
-//		if decodedInput, err := core.ParseCallData([]byte(rawInput), c.GetAbi().(string)); err != nil {
-//			return nil
-//		} else {
-//			return &decodedInput.Inputs
-//		}
-//	}
-//
-//	return nil
-//}
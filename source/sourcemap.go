@@ -0,0 +1,106 @@
+package source
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Jump types of source map entries.
+const (
+	JumpInto    = "i"
+	JumpOutOf   = "o"
+	JumpRegular = "-"
+)
+
+// SourceMapEntry is the entry of a compact source map describing a single
+// instruction. FileIndex is -1 for instructions which map into no source.
+type SourceMapEntry struct {
+	Start         int
+	Length        int
+	FileIndex     int
+	Jump          string
+	ModifierDepth int
+}
+
+var sourceMapFields = []string{"start", "length", "file index", "jump", "modifier depth"}
+
+// ParseCompactSourceMap parses a source map in the compact format solc emits,
+// where entries are separated by semicolons and hold the s:l:f:j:m fields of
+// an instruction. Trailing fields can be left out and any field can be left
+// empty, in which case it is taken from the previous entry. Fields the first
+// entry leaves out default to an instruction mapping into no source.
+func ParseCompactSourceMap(raw string) ([]SourceMapEntry, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	entries := strings.Split(raw, ";")
+	parsed := make([]SourceMapEntry, len(entries))
+
+	current := SourceMapEntry{
+		Start:     -1,
+		Length:    -1,
+		FileIndex: -1,
+		Jump:      JumpRegular,
+	}
+
+	offset := 0
+	for index, entry := range entries {
+		fields := strings.Split(entry, ":")
+		if len(fields) > len(sourceMapFields) {
+			return nil, fmt.Errorf("failed parsing source map entry %d at offset %d, err: too many fields in %q", index, offset, entry)
+		}
+
+		fieldOffset := offset
+		for field, value := range fields {
+			if value != "" {
+				err := current.set(field, value)
+				if err != nil {
+					return nil, fmt.Errorf("failed parsing source map entry %d at offset %d, %s %q: %s",
+						index, fieldOffset, sourceMapFields[field], value, err)
+				}
+			}
+
+			fieldOffset += len(value) + 1
+		}
+
+		parsed[index] = current
+		offset += len(entry) + 1
+	}
+
+	return parsed, nil
+}
+
+func (entry *SourceMapEntry) set(field int, value string) error {
+	if field == 3 {
+		switch value {
+		case JumpInto, JumpOutOf, JumpRegular:
+			entry.Jump = value
+			return nil
+		default:
+			return fmt.Errorf("unknown jump type")
+		}
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("not an integer")
+	}
+
+	switch field {
+	case 0:
+		entry.Start = number
+	case 1:
+		entry.Length = number
+	case 2:
+		entry.FileIndex = number
+	case 4:
+		if number < 0 {
+			return fmt.Errorf("negative modifier depth")
+		}
+		entry.ModifierDepth = number
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package source
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseCompactSourceMap(t *testing.T) {
+	entries, err := ParseCompactSourceMap("0:10:1:-:0;;2:3;:::i;::2:o:1;5::-1;::::")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []SourceMapEntry{
+		{Start: 0, Length: 10, FileIndex: 1, Jump: JumpRegular},
+		{Start: 0, Length: 10, FileIndex: 1, Jump: JumpRegular},
+		{Start: 2, Length: 3, FileIndex: 1, Jump: JumpRegular},
+		{Start: 2, Length: 3, FileIndex: 1, Jump: JumpInto},
+		{Start: 2, Length: 3, FileIndex: 2, Jump: JumpOutOf, ModifierDepth: 1},
+		{Start: 5, Length: 3, FileIndex: -1, Jump: JumpOutOf, ModifierDepth: 1},
+		{Start: 5, Length: 3, FileIndex: -1, Jump: JumpOutOf, ModifierDepth: 1},
+	}
+
+	if len(entries) != len(expected) {
+		t.Fatalf("expected %d entries, got %d", len(expected), len(entries))
+	}
+	for i, entry := range entries {
+		if entry != expected[i] {
+			t.Errorf("entry %d: expected %+v, got %+v", i, expected[i], entry)
+		}
+	}
+}
+
+func TestParseCompactSourceMapOmittedFirstFields(t *testing.T) {
+	entries, err := ParseCompactSourceMap(";:5;1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []SourceMapEntry{
+		{Start: -1, Length: -1, FileIndex: -1, Jump: JumpRegular},
+		{Start: -1, Length: 5, FileIndex: -1, Jump: JumpRegular},
+		{Start: 1, Length: 5, FileIndex: -1, Jump: JumpRegular},
+	}
+
+	for i, entry := range entries {
+		if entry != expected[i] {
+			t.Errorf("entry %d: expected %+v, got %+v", i, expected[i], entry)
+		}
+	}
+}
+
+func TestParseCompactSourceMapErrors(t *testing.T) {
+	tests := []struct {
+		raw      string
+		position string
+	}{
+		{raw: "1:2:x", position: "entry 0 at offset 4, file index"},
+		{raw: "1:2:0;3:4:0:j", position: "entry 1 at offset 12, jump"},
+		{raw: "1:2:0;;:::-:-1", position: "entry 2 at offset 12, modifier depth"},
+		{raw: "1:2:0:-:0:1", position: "entry 0 at offset 0"},
+	}
+
+	for _, test := range tests {
+		_, err := ParseCompactSourceMap(test.raw)
+		if err == nil {
+			t.Errorf("%s: expected an error", test.raw)
+			continue
+		}
+		if !strings.Contains(err.Error(), test.position) {
+			t.Errorf("%s: expected error at %q, got %q", test.raw, test.position, err)
+		}
+	}
+}
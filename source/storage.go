@@ -0,0 +1,263 @@
+package source
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tenderly/tenderly-trace/ethereum/core/types"
+)
+
+const slotSize = 32
+
+// StateVariable is a state variable declaration, as laid out by
+// ComputeStorageLayout.
+type StateVariable struct {
+	AstId          int
+	Contract       string
+	Name           string
+	TypeIdentifier string
+}
+
+// ComputeStorageLayout places the state variables in storage the same way the
+// Solidity compiler does. The variables must be in declaration order, with the
+// variables of the most base contract of the inheritance linearization first,
+// and without constants and immutables, which aren't kept in storage.
+//
+// Variables are packed into a slot as long as they fit, while structs, arrays
+// and mappings always start a new slot and so does whatever follows them.
+func ComputeStorageLayout(variables []StateVariable, definitions types.Definitions) (*types.StorageLayout, error) {
+	builder := &layoutBuilder{
+		definitions: definitions,
+		types:       make(map[string]*types.StorageType),
+	}
+
+	var members []layoutMember
+	for _, variable := range variables {
+		t, err := types.ParseTypeIdentifier(variable.TypeIdentifier)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing type of state variable %s, err: %s", variable.Name, err)
+		}
+
+		members = append(members, layoutMember{
+			astId:    variable.AstId,
+			contract: variable.Contract,
+			label:    variable.Name,
+			t:        t,
+		})
+	}
+
+	storage, _, err := builder.place(members)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.StorageLayout{
+		Storage: storage,
+		Types:   builder.types,
+	}, nil
+}
+
+type layoutMember struct {
+	astId    int
+	contract string
+	label    string
+	t        *types.SolType
+}
+
+type layoutBuilder struct {
+	definitions types.Definitions
+	types       map[string]*types.StorageType
+}
+
+// place packs the members into consecutive slots starting from zero and
+// returns their placement together with the number of slots they take.
+func (b *layoutBuilder) place(members []layoutMember) ([]*types.StorageVariable, int, error) {
+	var placed []*types.StorageVariable
+
+	slot, offset := 0, 0
+	for _, member := range members {
+		key, size, err := b.addType(member.t)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		packed := size < slotSize && !startsSlot(member.t)
+		if !packed || offset+size > slotSize {
+			if offset > 0 {
+				slot++
+			}
+			offset = 0
+		}
+
+		placed = append(placed, &types.StorageVariable{
+			AstId:    member.astId,
+			Contract: member.contract,
+			Label:    member.label,
+			Offset:   offset,
+			Slot:     strconv.Itoa(slot),
+			Type:     key,
+		})
+
+		if packed {
+			offset += size
+		} else {
+			slot += (size + slotSize - 1) / slotSize
+		}
+	}
+	if offset > 0 {
+		slot++
+	}
+
+	return placed, slot, nil
+}
+
+// addType registers the type in the layout and returns its key together with
+// the number of bytes it takes.
+func (b *layoutBuilder) addType(t *types.SolType) (string, int, error) {
+	key := strings.TrimSuffix(t.Identifier, "_ptr")
+	if existing, ok := b.types[key]; ok {
+		size, _ := strconv.Atoi(existing.NumberOfBytes)
+		return key, size, nil
+	}
+
+	st := &types.StorageType{
+		Encoding: types.InplaceEncoding,
+		Label:    typeLabel(t),
+	}
+	// Registered ahead of its contents, so recursive structs end up
+	// referring to themselves.
+	b.types[key] = st
+
+	size := 0
+	switch t.Kind {
+	case types.UintKind, types.IntKind:
+		size = t.Size / 8
+	case types.BoolKind:
+		size = 1
+	case types.AddressKind, types.ContractKind:
+		size = 20
+	case types.FixedBytesKind:
+		size = t.Size
+	case types.EnumKind:
+		size = 1
+		if definition := b.definitions[t.Id]; definition != nil && len(definition.Members) > 256 {
+			size = 2
+		}
+	case types.FunctionKind:
+		size = 8
+		if strings.HasPrefix(t.Identifier, "t_function_external") {
+			size = 24
+		}
+	case types.BytesKind, types.StringKind:
+		st.Encoding = types.BytesEncoding
+		size = slotSize
+	case types.MappingKind:
+		st.Encoding = types.MappingEncoding
+		size = slotSize
+
+		var err error
+		st.Key, _, err = b.addType(t.Key)
+		if err != nil {
+			return "", 0, err
+		}
+		st.Value, _, err = b.addType(t.Value)
+		if err != nil {
+			return "", 0, err
+		}
+	case types.ArrayKind:
+		base, elemSize, err := b.addType(t.Elem)
+		if err != nil {
+			return "", 0, err
+		}
+		st.Base = base
+
+		if t.IsDynamicArray() {
+			st.Encoding = types.DynamicArrayEncoding
+			size = slotSize
+		} else if elemSize < slotSize && !startsSlot(t.Elem) {
+			perSlot := slotSize / elemSize
+			size = (t.Length + perSlot - 1) / perSlot * slotSize
+		} else {
+			size = t.Length * ((elemSize + slotSize - 1) / slotSize) * slotSize
+		}
+	case types.StructKind:
+		definition := b.definitions[t.Id]
+		if definition == nil {
+			return "", 0, fmt.Errorf("missing definition of struct %s", t.Name)
+		}
+
+		var members []layoutMember
+		for _, member := range definition.Members {
+			memberType, err := types.ParseTypeIdentifier(member.TypeName.TypeDescription.TypeIdentifier)
+			if err != nil {
+				return "", 0, fmt.Errorf("failed parsing type of member %s of struct %s, err: %s", member.Name, t.Name, err)
+			}
+
+			members = append(members, layoutMember{
+				astId: member.Id,
+				label: member.Name,
+				t:     memberType,
+			})
+		}
+
+		placed, slots, err := b.place(members)
+		if err != nil {
+			return "", 0, err
+		}
+		st.Members = placed
+		size = slots * slotSize
+	default:
+		return "", 0, fmt.Errorf("type %s can't be kept in storage", t.Identifier)
+	}
+
+	st.NumberOfBytes = strconv.Itoa(size)
+	return key, size, nil
+}
+
+// startsSlot reports whether values of the type always start a new slot.
+func startsSlot(t *types.SolType) bool {
+	switch t.Kind {
+	case types.ArrayKind, types.StructKind, types.MappingKind, types.BytesKind, types.StringKind:
+		return true
+	}
+
+	return false
+}
+
+// typeLabel returns the name of the type as written in Solidity.
+func typeLabel(t *types.SolType) string {
+	switch t.Kind {
+	case types.UintKind:
+		return "uint" + strconv.Itoa(t.Size)
+	case types.IntKind:
+		return "int" + strconv.Itoa(t.Size)
+	case types.BoolKind:
+		return "bool"
+	case types.AddressKind:
+		return "address"
+	case types.FixedBytesKind:
+		return "bytes" + strconv.Itoa(t.Size)
+	case types.BytesKind:
+		return "bytes"
+	case types.StringKind:
+		return "string"
+	case types.EnumKind:
+		return "enum " + t.Name
+	case types.ContractKind:
+		return "contract " + t.Name
+	case types.StructKind:
+		return "struct " + t.Name
+	case types.FunctionKind:
+		return "function"
+	case types.MappingKind:
+		return fmt.Sprintf("mapping(%s => %s)", typeLabel(t.Key), typeLabel(t.Value))
+	case types.ArrayKind:
+		if t.IsDynamicArray() {
+			return typeLabel(t.Elem) + "[]"
+		}
+		return fmt.Sprintf("%s[%d]", typeLabel(t.Elem), t.Length)
+	}
+
+	return t.Identifier
+}
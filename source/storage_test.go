@@ -0,0 +1,155 @@
+package source
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tenderly/tenderly-trace/ethereum/core/types"
+)
+
+func member(id int, name, identifier string) types.Node {
+	return types.Node{
+		Id:       id,
+		Name:     name,
+		TypeName: types.TypeName{TypeDescription: types.TypeDescriptions{TypeIdentifier: identifier}},
+	}
+}
+
+// pairDefinitions defines struct Pair { uint128 x; uint256 y; }.
+var pairDefinitions = types.Definitions{
+	5: {
+		Id:       5,
+		Name:     "Pair",
+		NodeType: "StructDefinition",
+		Members:  []types.Node{member(3, "x", "t_uint128"), member(4, "y", "t_uint256")},
+	},
+}
+
+// placement formats the placement of variables as "label slot:offset".
+func placement(variables []*types.StorageVariable) string {
+	var placed []string
+	for _, variable := range variables {
+		placed = append(placed, fmt.Sprintf("%s %s:%d", variable.Label, variable.Slot, variable.Offset))
+	}
+	return strings.Join(placed, ", ")
+}
+
+func TestComputeStorageLayout(t *testing.T) {
+	tests := []struct {
+		name      string
+		variables []StateVariable
+		expected  string
+	}{
+		{
+			name: "small types packed",
+			variables: []StateVariable{
+				{Name: "a", TypeIdentifier: "t_uint128"},
+				{Name: "b", TypeIdentifier: "t_uint128"},
+				{Name: "c", TypeIdentifier: "t_uint8"},
+				{Name: "d", TypeIdentifier: "t_address"},
+				{Name: "e", TypeIdentifier: "t_bool"},
+				{Name: "f", TypeIdentifier: "t_bytes16"},
+			},
+			expected: "a 0:0, b 0:16, c 1:0, d 1:1, e 1:21, f 2:0",
+		},
+		{
+			name: "struct starting a new slot",
+			variables: []StateVariable{
+				{Name: "a", TypeIdentifier: "t_uint8"},
+				{Name: "p", TypeIdentifier: "t_struct$_Pair_$5_storage"},
+				{Name: "b", TypeIdentifier: "t_uint8"},
+			},
+			expected: "a 0:0, p 1:0, b 3:0",
+		},
+		{
+			name: "static arrays starting a new slot",
+			variables: []StateVariable{
+				{Name: "a", TypeIdentifier: "t_uint8"},
+				{Name: "small", TypeIdentifier: "t_array$_t_uint8_$3_storage"},
+				{Name: "b", TypeIdentifier: "t_uint8"},
+				{Name: "large", TypeIdentifier: "t_array$_t_uint256_$2_storage"},
+				{Name: "c", TypeIdentifier: "t_uint8"},
+			},
+			expected: "a 0:0, small 1:0, b 2:0, large 3:0, c 5:0",
+		},
+		{
+			name: "mappings and strings starting a new slot",
+			variables: []StateVariable{
+				{Name: "a", TypeIdentifier: "t_uint8"},
+				{Name: "m", TypeIdentifier: "t_mapping$_t_address_$_t_uint256_$"},
+				{Name: "s", TypeIdentifier: "t_string_storage"},
+				{Name: "b", TypeIdentifier: "t_uint8"},
+			},
+			expected: "a 0:0, m 1:0, s 2:0, b 3:0",
+		},
+		{
+			name: "inherited variables first",
+			variables: []StateVariable{
+				{Contract: "Base.sol:Base", Name: "owner", TypeIdentifier: "t_address"},
+				{Contract: "Base.sol:Base", Name: "paused", TypeIdentifier: "t_bool"},
+				{Contract: "Token.sol:Token", Name: "decimals", TypeIdentifier: "t_uint8"},
+				{Contract: "Token.sol:Token", Name: "supply", TypeIdentifier: "t_uint256"},
+			},
+			expected: "owner 0:0, paused 0:20, decimals 0:21, supply 1:0",
+		},
+	}
+
+	for _, test := range tests {
+		layout, err := ComputeStorageLayout(test.variables, pairDefinitions)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+
+		if placed := placement(layout.Storage); placed != test.expected {
+			t.Errorf("%s: expected %s, got %s", test.name, test.expected, placed)
+		}
+		for i, variable := range layout.Storage {
+			if variable.Contract != test.variables[i].Contract {
+				t.Errorf("%s: expected %s declared by %q, got %q", test.name, variable.Label, test.variables[i].Contract, variable.Contract)
+			}
+		}
+	}
+}
+
+func TestComputeStorageLayoutTypes(t *testing.T) {
+	layout, err := ComputeStorageLayout([]StateVariable{
+		{Name: "pairs", TypeIdentifier: "t_array$_t_struct$_Pair_$5_storage_$dyn_storage"},
+		{Name: "small", TypeIdentifier: "t_array$_t_uint8_$40_storage"},
+	}, pairDefinitions)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	pair := layout.Types["t_struct$_Pair_$5_storage"]
+	if pair == nil {
+		t.Fatalf("expected the struct registered")
+	}
+	if pair.Label != "struct Pair" || pair.NumberOfBytes != "64" {
+		t.Errorf("expected struct Pair of 64 bytes, got %s of %s bytes", pair.Label, pair.NumberOfBytes)
+	}
+	if placed := placement(pair.Members); placed != "x 0:0, y 1:0" {
+		t.Errorf("expected the members placed from the slot of the struct, got %s", placed)
+	}
+
+	pairs := layout.Types["t_array$_t_struct$_Pair_$5_storage_$dyn_storage"]
+	if pairs == nil || pairs.Encoding != types.DynamicArrayEncoding || pairs.Label != "struct Pair[]" {
+		t.Errorf("expected a dynamic array of pairs, got %+v", pairs)
+	}
+
+	// 40 bytes packed into two slots.
+	small := layout.Types["t_array$_t_uint8_$40_storage"]
+	if small == nil || small.NumberOfBytes != "64" || small.Label != "uint8[40]" {
+		t.Errorf("expected uint8[40] of 64 bytes, got %+v", small)
+	}
+}
+
+func TestComputeStorageLayoutMissingStruct(t *testing.T) {
+	_, err := ComputeStorageLayout([]StateVariable{
+		{Name: "p", TypeIdentifier: "t_struct$_Other_$9_storage"},
+	}, pairDefinitions)
+	if err == nil {
+		t.Errorf("expected an error for a struct without a definition")
+	}
+}
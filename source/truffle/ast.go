@@ -1,15 +1,27 @@
 package truffle
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/tenderly/tenderly-trace/ethereum/core/types"
 	"github.com/tenderly/tenderly-trace/source"
 )
 
 type TempAst map[string]*types.Node
 
+// ParseAst maps the instructions of the contract to the AST nodes they were
+// compiled from, which can be declared in any source of the build, like the
+// functions of base contracts and libraries.
 func ParseAst(contract *Contract, sourceMap source.SourceMap) types.Ast {
-	tast := make(TempAst)
-	recursiveNodeParse(tast, contract.Ast.Nodes)
+	var tast TempAst
+	if contract.index != nil {
+		tast = contract.index.allNodes()
+	} else {
+		tast = make(TempAst)
+		recursiveNodeParse(tast, contract.Ast.Nodes)
+	}
 
 	ast := make(types.Ast)
 
@@ -37,6 +49,27 @@ func ParseStateVariables(contract *Contract) []*types.Node {
 	return sv
 }
 
+// ParseDefinitions collects the struct and enum definitions of the contract's
+// source unit, including the ones declared inside contracts.
+func ParseDefinitions(contract *Contract) types.Definitions {
+	definitions := make(types.Definitions)
+	collectDefinitions(definitions, contract.Ast.Nodes)
+
+	return definitions
+}
+
+func collectDefinitions(definitions types.Definitions, nodes []Node) {
+	for _, node := range nodes {
+		if node.NodeType == "StructDefinition" || node.NodeType == "EnumDefinition" {
+			definitions[node.Id] = convert(node)
+		}
+
+		if node.Nodes != nil {
+			collectDefinitions(definitions, node.Nodes)
+		}
+	}
+}
+
 func recursiveNodeParse(tast TempAst, nodes []Node, contractName ...string) TempAst {
 	for _, node := range nodes {
 		tast[node.Src] = convert(node)
@@ -67,6 +100,7 @@ func recursiveNodeParse(tast TempAst, nodes []Node, contractName ...string) Temp
 
 func convert(node Node) *types.Node {
 	return &types.Node{
+		Id:            node.Id,
 		Name:          node.Name,
 		NodeType:      node.NodeType,
 		StateVariable: node.StateVariable,
@@ -94,6 +128,7 @@ func convert(node Node) *types.Node {
 			Parameters: convertArray(node.ReturnParameters.Parameters),
 			Src:        node.Parameters.Src,
 		},
+		Members: convertArray(node.Members),
 	}
 }
 
@@ -105,3 +140,183 @@ func convertArray(nodes []Node) []types.Node {
 
 	return snodes
 }
+
+// SourceUnit is a source file of a compilation, identified by the file index
+// source maps refer to it with.
+type SourceUnit struct {
+	Id      int
+	Path    string
+	Content string
+	Ast     *ContractAst
+
+	lines *source.LineIndex
+}
+
+// Lines returns the line index of the source, built the first time it is
+// asked for.
+func (unit *SourceUnit) Lines() *source.LineIndex {
+	if unit.lines == nil {
+		unit.lines = source.NewLineIndex(unit.Content)
+	}
+
+	return unit.lines
+}
+
+// astIndex resolves contract, struct and enum definitions by their AST id
+// across the sources of all artifacts of a build, which share their ids. It
+// also keeps the sources of the build by their file index.
+type astIndex struct {
+	contracts   map[int]*Node
+	definitions types.Definitions
+	sources     map[int]*SourceUnit
+
+	// complete tells whether the sources are all sources of the build, as
+	// given by the compiler, so that file indexes past them belong to sources
+	// the compiler generated.
+	complete bool
+	nodes    TempAst
+}
+
+func newAstIndex(contracts []*Contract, sources []*SourceUnit) *astIndex {
+	index := &astIndex{
+		contracts:   make(map[int]*Node),
+		definitions: make(types.Definitions),
+		sources:     make(map[int]*SourceUnit),
+		complete:    len(sources) > 0,
+	}
+
+	for _, unit := range sources {
+		if unit.Ast != nil {
+			index.add(unit.Ast)
+		}
+		index.sources[unit.Id] = unit
+	}
+
+	// Without the sources of the build, the ones the artifacts are compiled
+	// from stand in for them.
+	for _, contract := range contracts {
+		if id, ok := fileIndex(contract.Ast.Src); ok {
+			if _, known := index.sources[id]; known {
+				continue
+			}
+
+			index.sources[id] = &SourceUnit{
+				Id:      id,
+				Path:    contract.SourcePath,
+				Content: contract.Source,
+				Ast:     &contract.Ast,
+			}
+		}
+
+		index.add(&contract.Ast)
+	}
+
+	return index
+}
+
+func (index *astIndex) add(sourceAst *ContractAst) {
+	collectDefinitions(index.definitions, sourceAst.Nodes)
+
+	for i := range sourceAst.Nodes {
+		node := &sourceAst.Nodes[i]
+		if node.NodeType == "ContractDefinition" {
+			index.contracts[node.Id] = node
+		}
+	}
+}
+
+// generated reports whether the file index belongs to no source or to one the
+// compiler generated.
+func (index *astIndex) generated(id int) bool {
+	if id < 0 {
+		return true
+	}
+
+	_, known := index.sources[id]
+	return index.complete && !known
+}
+
+// allNodes returns the nodes of all sources of the build by their source
+// range, parsed the first time they are asked for.
+func (index *astIndex) allNodes() TempAst {
+	if index.nodes != nil {
+		return index.nodes
+	}
+
+	index.nodes = make(TempAst)
+	for _, unit := range index.sources {
+		if unit.Ast != nil {
+			recursiveNodeParse(index.nodes, unit.Ast.Nodes)
+		}
+	}
+
+	return index.nodes
+}
+
+// fileIndex returns the file index of a source range, formatted as
+// start:length:file.
+func fileIndex(src string) (int, bool) {
+	parts := strings.Split(src, ":")
+	if len(parts) != 3 {
+		return 0, false
+	}
+
+	id, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return 0, false
+	}
+
+	return id, true
+}
+
+// ParseStorageLayout computes the storage layout of the contract from the
+// state variables of every contract it inherits from.
+func ParseStorageLayout(contract *Contract) (*types.StorageLayout, error) {
+	index := contract.index
+	if index == nil {
+		index = newAstIndex([]*Contract{contract}, nil)
+	}
+
+	var definition *Node
+	for i := range contract.Ast.Nodes {
+		node := &contract.Ast.Nodes[i]
+		if node.NodeType == "ContractDefinition" && node.Name == contract.Name {
+			definition = node
+			break
+		}
+	}
+	if definition == nil {
+		return nil, fmt.Errorf("missing definition of contract %s", contract.Name)
+	}
+
+	linearized := definition.LinearizedBaseContracts
+	if len(linearized) == 0 {
+		linearized = []int{definition.Id}
+	}
+
+	var variables []source.StateVariable
+	for i := len(linearized) - 1; i >= 0; i-- {
+		base, ok := index.contracts[linearized[i]]
+		if !ok {
+			return nil, fmt.Errorf("missing definition of base contract %d of %s", linearized[i], contract.Name)
+		}
+
+		for _, node := range base.Nodes {
+			if node.NodeType != "VariableDeclaration" || !node.StateVariable {
+				continue
+			}
+			if node.Constant || node.Mutability == "constant" || node.Mutability == "immutable" {
+				continue
+			}
+
+			variables = append(variables, source.StateVariable{
+				AstId:          node.Id,
+				Contract:       base.Name,
+				Name:           node.Name,
+				TypeIdentifier: node.TypeDescriptions.TypeIdentifier,
+			})
+		}
+	}
+
+	return source.ComputeStorageLayout(variables, index.definitions)
+}
@@ -1,9 +1,12 @@
 package truffle
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/tenderly/tenderly-trace/ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum/signer/accounts/abi"
 	"github.com/tenderly/tenderly-trace/source"
+	"log"
 	"time"
 )
 
@@ -41,11 +44,24 @@ type Contract struct {
 	Ast                     ContractAst `json:"ast"`
 	ParsedStateVariable     []*types.Node
 	ParsedAst               types.Ast
-	Compiler                ContractCompiler           `json:"compiler"`
-	Networks                map[string]ContractNetwork `json:"networks"`
+	ParsedDefinitions       types.Definitions
+	StorageLayout           *types.StorageLayout `json:"storageLayout"`
+	ParsedStorageLayout     *types.StorageLayout
+	ParsedAbi               *abi.ABI
+	Compiler                ContractCompiler              `json:"compiler"`
+	Networks                map[string]ContractNetwork    `json:"networks"`
+	ImmutableReferences     map[string][]source.CodeRange `json:"immutableReferences"`
 
 	SchemaVersion string    `json:"schemaVersion"`
 	UpdatedAt     time.Time `json:"updatedAt"`
+
+	// index resolves declarations made in the sources of other artifacts,
+	// like base contracts.
+	index *astIndex
+
+	// storageLayoutErr is why the storage layout couldn't be computed, which
+	// is only tried once.
+	storageLayoutErr error
 }
 
 type Expression struct {
@@ -101,53 +117,133 @@ type Parameters struct {
 }
 
 type Node struct {
-	Body                 Body             `json:"body"`
-	BaseContracts        []string         `json:"baseContracts"`
-	ContractDependencies []string         `json:"contractDependencies"`
-	ContractKind         string           `json:"contractKind"`
-	Documentation        interface{}      `json:"documentation"`
-	FullyImplemented     bool             `json:"fullyImplemented"`
-	Id                   int              `json:"id"`
-	Literals             []string         `json:"literals"`
-	Implemented          bool             `json:"implemented"`
-	IsConstructor        bool             `json:"isConstructor"`
-	IsDeclaredConst      bool             `json:"isDeclaredConst"`
-	Modifiers            []interface{}    `json:"modifiers"`
-	Constant             bool             `json:"constant"`
-	Name                 string           `json:"name"`
-	Nodes                []Node           `json:"nodes"`
-	NodeType             string           `json:"nodeType"`
-	Parameters           Parameters       `json:"parameters"`
-	Payable              bool             `json:"payable"`
-	ReturnParameters     Parameters       `json:"returnParameters"`
-	Scope                int              `json:"scope"`
-	Src                  string           `json:"src"`
-	StateVariable        bool             `json:"stateVariable"`
-	StorageLocation      string           `json:"storageLocation"`
-	TypeDescriptions     TypeDescriptions `json:"typeDescriptions"`
-	TypeName             TypeName         `json:"typeName"`
-	Value                *string          `json:"value"`
-	StateMutability      string           `json:"stateMutability"`
-	SuperFunction        *string          `json:"superFunction"`
-	Visibility           string           `json:"visibility"`
+	Body                    Body             `json:"body"`
+	BaseContracts           []interface{}    `json:"baseContracts"`
+	ContractDependencies    []int            `json:"contractDependencies"`
+	ContractKind            string           `json:"contractKind"`
+	Documentation           interface{}      `json:"documentation"`
+	FullyImplemented        bool             `json:"fullyImplemented"`
+	Id                      int              `json:"id"`
+	Literals                []string         `json:"literals"`
+	LinearizedBaseContracts []int            `json:"linearizedBaseContracts"`
+	Members                 []Node           `json:"members"`
+	Implemented             bool             `json:"implemented"`
+	IsConstructor           bool             `json:"isConstructor"`
+	IsDeclaredConst         bool             `json:"isDeclaredConst"`
+	Modifiers               []interface{}    `json:"modifiers"`
+	Mutability              string           `json:"mutability"`
+	Constant                bool             `json:"constant"`
+	Name                    string           `json:"name"`
+	Nodes                   []Node           `json:"nodes"`
+	NodeType                string           `json:"nodeType"`
+	Parameters              Parameters       `json:"parameters"`
+	Payable                 bool             `json:"payable"`
+	ReturnParameters        Parameters       `json:"returnParameters"`
+	Scope                   int              `json:"scope"`
+	Src                     string           `json:"src"`
+	StateVariable           bool             `json:"stateVariable"`
+	StorageLocation         string           `json:"storageLocation"`
+	TypeDescriptions        TypeDescriptions `json:"typeDescriptions"`
+	TypeName                TypeName         `json:"typeName"`
+	Value                   interface{}      `json:"value"`
+	StateMutability         string           `json:"stateMutability"`
+	SuperFunction           interface{}      `json:"superFunction"`
+	Visibility              string           `json:"visibility"`
+}
+
+func (c *Contract) GetContractName() string {
+	return c.Name
 }
 
+// GetContractAst maps the instructions of the contract to their AST nodes.
+// The mapping is made once, as every call into the contract needs it.
 func (c *Contract) GetContractAst(sourceMap source.SourceMap) types.Ast {
 	if c.ParsedAst != nil {
 		return c.ParsedAst
 	}
+	c.ParsedAst = ParseAst(c, sourceMap)
 
-	return ParseAst(c, sourceMap)
+	return c.ParsedAst
 }
 
 func (c *Contract) GetContractStateVariables() []*types.Node {
 	if c.ParsedStateVariable != nil {
 		return c.ParsedStateVariable
 	}
+	c.ParsedStateVariable = ParseStateVariables(c)
+
+	return c.ParsedStateVariable
+}
+
+func (c *Contract) GetContractDefinitions() types.Definitions {
+	if c.ParsedDefinitions != nil {
+		return c.ParsedDefinitions
+	}
+	if c.index != nil {
+		return c.index.definitions
+	}
+	c.ParsedDefinitions = ParseDefinitions(c)
+
+	return c.ParsedDefinitions
+}
+
+func (c *Contract) GetContractStorageLayout() *types.StorageLayout {
+	if c.StorageLayout != nil {
+		return c.StorageLayout
+	}
+	if c.ParsedStorageLayout != nil || c.storageLayoutErr != nil {
+		return c.ParsedStorageLayout
+	}
+
+	layout, err := ParseStorageLayout(c)
+	if err != nil {
+		log.Printf("unable to compute storage layout of %s, err %s", c.Name, err)
+		c.storageLayoutErr = err
+		return nil
+	}
+	c.ParsedStorageLayout = layout
+
+	return layout
+}
+
+// GetContractAbi parses the ABI of the artifact the first time it is asked
+// for, since it is needed by every call into the contract.
+func (c *Contract) GetContractAbi() *abi.ABI {
+	if c.ParsedAbi != nil {
+		return c.ParsedAbi
+	}
+
+	data, err := json.Marshal(c.Abi)
+	if err != nil {
+		return nil
+	}
+
+	var contractAbi abi.ABI
+	if err := json.Unmarshal(data, &contractAbi); err != nil {
+		return nil
+	}
+	c.ParsedAbi = &contractAbi
+
+	return c.ParsedAbi
+}
+
+func (c *Contract) GetContractDeployedBytecode() string {
+	return c.DeployedBytecode
+}
+
+// GetContractImmutableReferences returns the ranges of the deployed code
+// holding immutables, which are only filled in by the constructor.
+func (c *Contract) GetContractImmutableReferences() []source.CodeRange {
+	var ranges []source.CodeRange
+	for _, references := range c.ImmutableReferences {
+		ranges = append(ranges, references...)
+	}
 
-	return ParseStateVariables(c)
+	return ranges
 }
 
+// GetContractSourceMap parses the deployed source map the first time it is
+// asked for, so that repeated traces don't parse it again.
 func (c *Contract) GetContractSourceMap() (source.SourceMap, error) {
 	if c.ParsedDeployedSourceMap != nil {
 		return c.ParsedDeployedSourceMap, nil
@@ -157,6 +253,7 @@ func (c *Contract) GetContractSourceMap() (source.SourceMap, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse source map, err %s\n", err)
 	}
+	c.ParsedDeployedSourceMap = sourceMap
 
 	return sourceMap, nil
 }
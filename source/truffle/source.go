@@ -12,28 +12,52 @@ import (
 
 type ContractSource struct {
 	contracts map[string]*Contract
+	report    *source.LoadReport
+	networkID string
 }
 
+// GetSource also binds contracts to the addresses they are deployed at on the
+// network the Contract Source is scoped to. Deployments on other networks are
+// left out, as the same address holds other code there.
 func (cs ContractSource) GetSource() source.ContractSource {
 	cast := make(map[string]source.Contract)
+	addresses := make(map[string]source.Contract)
 	for k, v := range cs.contracts {
 		cast[k] = v
+
+		network, ok := v.Networks[cs.networkID]
+		if cs.networkID != "" && ok && network.Address != "" {
+			addresses[network.Address] = v
+		}
 	}
 
-	return source.ContractSource{Contracts: cast}
+	return source.NewContractSource(cast, addresses)
+}
+
+// Report lists the artifacts the Contract Source was built from, if it was
+// loaded from a build directory.
+func (cs ContractSource) Report() *source.LoadReport {
+	return cs.report
 }
 
 // NewContractSource builds the Contract Source from the provided config, and scoped to the provided network.
-func NewContractSource(absBuildDir string) (*ContractSource, error) {
-	truffleContracts, err := loadTruffleContracts(absBuildDir)
+func NewContractSource(absBuildDir string, networkID string) (*ContractSource, error) {
+	report := source.NewLoadReport("truffle", absBuildDir)
+	truffleContracts, err := loadTruffleContracts(absBuildDir, report)
 	if err != nil {
 		return nil, err
 	}
 
-	return mapTruffleContracts(truffleContracts), nil
+	contractSource := MapContracts(truffleContracts, nil)
+	contractSource.report = report
+	contractSource.networkID = networkID
+
+	return contractSource, nil
 }
 
-func loadTruffleContracts(absBuildDir string) ([]*Contract, error) {
+// loadTruffleContracts reads the artifacts of all contracts with code.
+// Interfaces and abstract contracts have no code to trace.
+func loadTruffleContracts(absBuildDir string, report *source.LoadReport) ([]*Contract, error) {
 	files, err := ioutil.ReadDir(absBuildDir)
 	if err != nil {
 		return nil, fmt.Errorf("failed listing truffle build files: %s", err)
@@ -45,7 +69,8 @@ func loadTruffleContracts(absBuildDir string) ([]*Contract, error) {
 			continue
 		}
 
-		data, err := ioutil.ReadFile(filepath.Join(absBuildDir, file.Name()))
+		path := filepath.Join(absBuildDir, file.Name())
+		data, err := ioutil.ReadFile(path)
 		if err != nil {
 			return nil, fmt.Errorf("failed reading truffle build files: %s", err)
 		}
@@ -53,19 +78,31 @@ func loadTruffleContracts(absBuildDir string) ([]*Contract, error) {
 		var contract Contract
 		err = json.Unmarshal(data, &contract)
 		if err != nil {
-			return nil, fmt.Errorf("failed parsing truffle build files: %s", err)
+			report.AddSkipped(path, fmt.Sprintf("failed parsing: %s", err))
+			continue
+		}
+		if len(contract.DeployedBytecode) <= 2 {
+			report.AddSkipped(path, "no deployed code")
+			continue
 		}
 
 		contracts = append(contracts, &contract)
+		report.AddLoaded(path, "")
 	}
 
 	return contracts, nil
 }
 
-func mapTruffleContracts(truffleContracts []*Contract) *ContractSource {
+// MapContracts builds the Contract Source from artifacts compiled together.
+// The sources of the compilation, if given, resolve the files source maps
+// point into and declarations made in files without artifacts of their own,
+// like structs declared outside contracts.
+func MapContracts(truffleContracts []*Contract, sources []*SourceUnit) *ContractSource {
 	contracts := make(map[string]*Contract)
+	index := newAstIndex(truffleContracts, sources)
 
 	for _, truffleContract := range truffleContracts {
+		truffleContract.index = index
 		contracts[truffleContract.DeployedBytecode] = truffleContract
 	}
 
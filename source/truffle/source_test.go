@@ -0,0 +1,98 @@
+package truffle
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// testBytecode calls a library which wasn't linked yet: PUSH20 <library>
+// DELEGATECALL STOP.
+const testBytecode = "0x73__$b5b1a3c0b9a3d1a2e5f6c7d8e9f0a1b2c3$__f400"
+
+func writeArtifact(t *testing.T, dir string, contract *Contract) {
+	t.Helper()
+
+	data, err := json.Marshal(contract)
+	if err != nil {
+		t.Fatalf("failed encoding artifact: %s", err)
+	}
+
+	err = ioutil.WriteFile(filepath.Join(dir, contract.Name+".json"), data, 0644)
+	if err != nil {
+		t.Fatalf("failed writing artifact: %s", err)
+	}
+}
+
+func TestContractSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "truffle")
+	if err != nil {
+		t.Fatalf("failed creating build directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeArtifact(t, dir, &Contract{
+		Name:              "Token",
+		DeployedBytecode:  testBytecode,
+		DeployedSourceMap: "0:10:0:-:0;19:10:0;35:1:0",
+		Source:            "contract Token {\n  function f() {}\n}",
+		SourcePath:        "/project/contracts/Token.sol",
+		Networks: map[string]ContractNetwork{
+			"1": {Address: "0x00000000000000000000000000000000000000aa"},
+			"5": {Address: "0x00000000000000000000000000000000000000bb"},
+		},
+	})
+
+	cs, err := NewContractSource(dir, "5")
+	if err != nil {
+		t.Fatalf("failed loading contracts: %s", err)
+	}
+	contracts := cs.GetSource()
+
+	if name := contracts.GetName("0x00000000000000000000000000000000000000BB", "0x"); name != "Token" {
+		t.Errorf("expected the deployment on the network bound, got %q", name)
+	}
+	if name := contracts.GetName("0x00000000000000000000000000000000000000aa", "0x"); name != "" {
+		t.Errorf("expected the deployment on another network not bound, got %q", name)
+	}
+
+	sourceMap := contracts.GetSourceMap("0x00000000000000000000000000000000000000bb", testBytecode)
+	if len(sourceMap) != len(strings.TrimPrefix(testBytecode, "0x"))/2 {
+		t.Fatalf("expected a mapping per byte of code, got %d", len(sourceMap))
+	}
+	for pc, line := range map[int]int{0: 1, 21: 2, 22: 3} {
+		if mapping := sourceMap.At(pc); mapping == nil || mapping.Line != line {
+			t.Errorf("expected the instruction at %d mapped to line %d, got %+v", pc, line, mapping)
+		}
+	}
+}
+
+// The storage layout is computed once, and so is the failure to compute it.
+func TestGetContractStorageLayout(t *testing.T) {
+	contract := &Contract{
+		Name: "Token",
+		Ast: ContractAst{
+			NodeType: "SourceUnit",
+			Nodes:    []Node{{Id: 1, NodeType: "ContractDefinition", Name: "Token"}},
+		},
+	}
+
+	layout := contract.GetContractStorageLayout()
+	if layout == nil {
+		t.Fatalf("expected the storage layout computed")
+	}
+	if cached := contract.GetContractStorageLayout(); cached != layout {
+		t.Errorf("expected the storage layout cached")
+	}
+
+	missing := &Contract{Name: "Missing"}
+	if layout := missing.GetContractStorageLayout(); layout != nil {
+		t.Errorf("expected no storage layout without a contract definition, got %+v", layout)
+	}
+	if missing.storageLayoutErr == nil {
+		t.Errorf("expected the failure recorded")
+	}
+}
@@ -3,10 +3,10 @@ package truffle
 import (
 	"encoding/hex"
 	"fmt"
+	"strings"
+
 	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
 	"github.com/tenderly/tenderly-trace/source"
-	"strconv"
-	"strings"
 )
 
 func ParseSourcecode(contract *Contract) (source.SourceMap, error) {
@@ -23,98 +23,97 @@ func ParseSourcecode(contract *Contract) (source.SourceMap, error) {
 		return nil, fmt.Errorf("sourcemap.Parse: %s", err)
 	}
 
-	rawSrc := contract.Source
+	own := &SourceUnit{
+		Path:    contract.SourcePath,
+		Content: contract.Source,
+	}
+	ownIndex, ownKnown := fileIndex(contract.Ast.Src)
 
-	for _, instruction := range memSrcMap {
-		if instruction == nil {
+	// Instructions share their mappings, which are resolved once each.
+	resolved := make(map[*source.InstructionMapping]bool)
+	for _, instruction := range instructionSrcMap {
+		if instruction == nil || resolved[instruction] {
 			continue
 		}
+		resolved[instruction] = true
+
+		// Instructions of inherited contracts and libraries map into the
+		// files they are declared in.
+		var unit *SourceUnit
+		if contract.index != nil {
+			unit = contract.index.sources[instruction.FileIndex]
+			instruction.Generated = contract.index.generated(instruction.FileIndex)
+		} else {
+			instruction.Generated = instruction.FileIndex < 0
+		}
 
-		i := 0
-		line := 1
-		column := 1
-
-		for i < instruction.Start && i < len(rawSrc) {
-			if rawSrc[i] == '\n' {
-				line++
-				column = 0
-			}
-
-			column++
-			i++
+		switch {
+		case unit != nil:
+		case instruction.Generated:
+			continue
+		case !ownKnown || instruction.FileIndex == ownIndex:
+			unit = own
+		default:
+			continue
 		}
 
-		instruction.Line = line
-		instruction.Column = column
+		instruction.File = unit.Path
+		instruction.Line, instruction.Column = unit.Lines().Position(instruction.Start)
+		instruction.Text = unit.firstLine(instruction.Start, instruction.Length)
 	}
 
 	return memSrcMap, nil
 }
 
-func parseInstructionSourceMap(rawSrcMap string) (source.SourceMap, error) {
-	instructionSrcMap := make(source.SourceMap)
-
-	var err error
-	var s int64
-	var l int64
-	var f int64
-	var j string
-	for index, mapping := range strings.Split(rawSrcMap, ";") {
-		if mapping == "" {
-			instructionSrcMap[index] = instructionSrcMap[index-1]
-			continue
-		}
+// firstLine returns the first line of the code in the range, or an empty
+// string if the range is outside of the source.
+func (unit *SourceUnit) firstLine(start int, length int) string {
+	if start < 0 || length < 0 || start+length > len(unit.Content) {
+		return ""
+	}
 
-		info := strings.Split(mapping, ":")
-		if len(info) > 0 && info[0] != "" {
-			s, err = strconv.ParseInt(info[0], 0, 64)
-			if err != nil {
-				return nil, fmt.Errorf("failed parsing integer: %s", err)
-			}
-		}
-		if len(info) > 1 && info[1] != "" {
-			l, err = strconv.ParseInt(info[1], 0, 64)
-			if err != nil {
-				return nil, fmt.Errorf("failed parsing integer: %s", err)
-			}
-		} else {
-			l = int64(instructionSrcMap[index-1].Length)
-		}
+	return strings.SplitN(unit.Content[start:start+length], "\n", 2)[0]
+}
 
-		if len(info) > 2 && info[2] != "" {
-			f, err = strconv.ParseInt(info[2], 0, 64)
-			if err != nil {
-				return nil, fmt.Errorf("failed parsing integer: %s", err)
-			}
-		} else {
-			f = int64(instructionSrcMap[index-1].FileIndex)
-		}
+// parseInstructionSourceMap parses the source map into the mapping of every
+// instruction. Instructions with the same mapping share it.
+func parseInstructionSourceMap(rawSrcMap string) ([]*source.InstructionMapping, error) {
+	entries, err := source.ParseCompactSourceMap(rawSrcMap)
+	if err != nil {
+		return nil, err
+	}
 
-		if len(info) > 3 && info[3] != "" {
-			j = info[3]
+	instructionSrcMap := make([]*source.InstructionMapping, len(entries))
+	mappings := make(map[source.SourceMapEntry]*source.InstructionMapping)
+	for index, entry := range entries {
+		if existing, ok := mappings[entry]; ok {
+			instructionSrcMap[index] = existing
+			continue
 		}
 
 		instructionSrcMap[index] = &source.InstructionMapping{
-			Src:       strconv.Itoa(int(s)) + ":" + strconv.Itoa(int(l)) + ":" + strconv.Itoa(int(f)),
-			Index:     index,
-			Start:     int(s),
-			Length:    int(l),
-			FileIndex: int(f),
-			Jump:      j,
+			Src:           fmt.Sprintf("%d:%d:%d", entry.Start, entry.Length, entry.FileIndex),
+			Index:         index,
+			Start:         entry.Start,
+			Length:        entry.Length,
+			FileIndex:     entry.FileIndex,
+			Jump:          entry.Jump,
+			ModifierDepth: entry.ModifierDepth,
 		}
+		mappings[entry] = instructionSrcMap[index]
 	}
 
 	return instructionSrcMap, nil
 }
 
-func convertToMemoryMap(sourceMap source.SourceMap, binData string) (source.SourceMap, error) {
-	memSrcMap := make(source.SourceMap)
-
-	bin, err := hex.DecodeString(binData[2:])
+func convertToMemoryMap(instructionSrcMap []*source.InstructionMapping, binData string) (source.SourceMap, error) {
+	bin, err := hex.DecodeString(zeroPlaceholders(strings.TrimPrefix(binData, "0x")))
 	if err != nil {
 		return nil, fmt.Errorf("failed decoding runtime binary: %s", err)
 	}
 
+	memSrcMap := make(source.SourceMap, len(bin))
+
 	instruction := 0
 	for i := 0; i < len(bin); i++ {
 
@@ -125,7 +124,9 @@ func convertToMemoryMap(sourceMap source.SourceMap, binData string) (source.Sour
 			extraPush = int(op - vm.PUSH1 + 1)
 		}
 
-		memSrcMap[i] = sourceMap[instruction]
+		if instruction < len(instructionSrcMap) {
+			memSrcMap[i] = instructionSrcMap[instruction]
+		}
 
 		instruction++
 		i += extraPush
@@ -133,3 +134,25 @@ func convertToMemoryMap(sourceMap source.SourceMap, binData string) (source.Sour
 
 	return memSrcMap, nil
 }
+
+// zeroPlaceholders replaces the placeholders of libraries which weren't linked
+// yet with the zero address, so the code decodes. Placeholders take the 20
+// bytes of an address, like __Library__ padded with underscores or __$hash$__.
+func zeroPlaceholders(hexCode string) string {
+	if !strings.Contains(hexCode, "_") {
+		return hexCode
+	}
+
+	code := []byte(hexCode)
+	for i := 0; i+40 <= len(code); i += 2 {
+		if code[i] != '_' {
+			continue
+		}
+		for j := i; j < i+40; j++ {
+			code[j] = '0'
+		}
+		i += 38
+	}
+
+	return string(code)
+}
@@ -0,0 +1,149 @@
+package tenderly
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
+	"github.com/tenderly/tenderly-trace/ethereum/eth/tracers"
+	"github.com/tenderly/tenderly-trace/source"
+	"github.com/tenderly/tenderly-trace/tenderly/calltree"
+	"github.com/tenderly/tenderly-trace/tenderly/stacktrace"
+)
+
+// CallTree re-executes the transaction with the default tracer and returns
+// its call tree, with the contracts of the given source named and the
+// failures located in their sources.
+func (t Tenderly) CallTree(txHash string, cs source.Source) (*calltree.Call, error) {
+	tracer, err := newCodeTracer()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = t.replay(txHash, cs, tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	return tracer.tree(cs.GetSource())
+}
+
+// SimulateCallTree simulates the call the way Simulate does and returns its
+// call tree the way CallTree does.
+func (t Tenderly) SimulateCallTree(call *Call, cs source.Source) (*calltree.Call, error) {
+	tracer, err := newCodeTracer()
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.simulate(call, cs, tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	return tracer.tree(cs.GetSource())
+}
+
+// codeTracer runs the default tracer, keeping the code executed at every
+// address so the calls of the tree it reports can be resolved afterwards.
+type codeTracer struct {
+	tracers.ResultTracer
+	codes map[common.Address][]byte
+}
+
+func newCodeTracer() (*codeTracer, error) {
+	tracer, err := newTracer(&TraceOptions{Tracer: DefaultTracer})
+	if err != nil {
+		return nil, err
+	}
+
+	return &codeTracer{
+		ResultTracer: tracer,
+		codes:        make(map[common.Address][]byte),
+	}, nil
+}
+
+func (t *codeTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	// Delegate calls run the code of another address than their own, and
+	// contract creations run init code which isn't stored at the address.
+	address := contract.Address()
+	if contract.CodeAddr != nil {
+		address = *contract.CodeAddr
+	}
+	if _, ok := t.codes[address]; !ok && bytes.Equal(contract.Code, env.StateDB.GetCode(address)) {
+		t.codes[address] = contract.Code
+	}
+
+	return t.ResultTracer.CaptureState(env, pc, op, gas, cost, memory, stack, contract, depth, err)
+}
+
+// tree parses the call tree reported by the tracer, naming the contracts its
+// calls run the code of and locating the instructions failed calls stopped
+// at.
+func (t *codeTracer) tree(contracts source.ContractSource) (*calltree.Call, error) {
+	result, err := t.GetResult()
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := calltree.Parse(result)
+	if err != nil {
+		return nil, err
+	}
+
+	root.Walk(func(call *calltree.Call, external *calltree.Call) {
+		code, ok := t.codes[common.HexToAddress(external.To)]
+		if external.To == "" || !ok {
+			return
+		}
+
+		hexCode := "0x" + hex.EncodeToString(code)
+		call.Contract = contracts.GetName(external.To, hexCode)
+
+		if call.ErrorPC == nil {
+			return
+		}
+
+		pc := int(*call.ErrorPC)
+		mapping := contracts.GetSourceMap(external.To, hexCode).At(pc)
+		if mapping != nil && pc < len(code) {
+			call.Failure = failureFrame(mapping, vm.OpCode(code[pc]), call.Contract)
+		}
+	})
+
+	return root, nil
+}
+
+// failureFrame builds the stack frame of the instruction a call failed at.
+// Instructions without a source are attributed to the contract.
+func failureFrame(mapping *source.InstructionMapping, op vm.OpCode, contract string) *stacktrace.Frame {
+	file := mapping.File
+	switch {
+	case mapping.Generated:
+		file = "<generated>"
+	case file == "":
+		file = contract
+	}
+
+	return &stacktrace.Frame{
+		File:   file,
+		Line:   mapping.Line,
+		Column: mapping.Column,
+		Start:  mapping.Start,
+		Length: mapping.Length,
+		Text:   mapping.Text,
+		Op:     op.String(),
+		Mapping: &stacktrace.InstructionMapping{
+			Start:         mapping.Start,
+			Length:        mapping.Length,
+			Line:          mapping.Line,
+			Column:        mapping.Column,
+			File:          mapping.File,
+			FileIndex:     mapping.FileIndex,
+			Generated:     mapping.Generated,
+			Jump:          mapping.Jump,
+			ModifierDepth: mapping.ModifierDepth,
+		},
+	}
+}
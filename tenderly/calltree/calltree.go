@@ -0,0 +1,161 @@
+package calltree
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/tenderly/tenderly-trace/tenderly/stacktrace"
+)
+
+// Internal is the type of internal function calls, which the callTracerFinal
+// tracer reports as JUMPDEST calls.
+const Internal = "JUMPDEST"
+
+// revertError is the error of calls which reverted on their own.
+const revertError = "execution reverted"
+
+// Call is a call of the tree reported by the callTracerFinal tracer, either an
+// external call or an internal function call.
+type Call struct {
+	Type      string `json:"type"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Func      string `json:"func"`
+	Signature string `json:"signature"`
+	Value     string `json:"value"`
+	Gas       string `json:"gas"`
+	GasUsed   string `json:"gasUsed"`
+
+	Input         string     `json:"input"`
+	DecodedInput  []Argument `json:"decodedInput"`
+	Output        string     `json:"output"`
+	DecodedOutput []Argument `json:"decodedOutput"`
+	Logs          []Log      `json:"logs"`
+
+	Error   string  `json:"error"`
+	ErrorPC *uint64 `json:"errorPC"`
+	Revert  *Revert `json:"revert"`
+
+	Calls []*Call `json:"calls"`
+
+	// Contract is the name of the contract the call runs the code of, and is
+	// empty when it isn't known.
+	Contract string `json:"-"`
+	// Failure locates the instruction the call failed at in the source, and
+	// is nil for calls which didn't fail or whose source isn't known.
+	Failure *stacktrace.Frame `json:"-"`
+}
+
+// Argument is a decoded input or output of a call, event or error.
+type Argument struct {
+	Name  string          `json:"name"`
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// Log is a log emitted by a call, decoded against the event that emitted it
+// when it is known.
+type Log struct {
+	Address       string     `json:"address"`
+	Name          string     `json:"name"`
+	Signature     string     `json:"signature"`
+	DecodedInputs []Argument `json:"decodedInputs"`
+	Topics        []string   `json:"topics"`
+	Data          string     `json:"data"`
+}
+
+// Revert is the decoded data a call reverted with.
+type Revert struct {
+	Kind      string     `json:"kind"`
+	Name      string     `json:"name"`
+	Signature string     `json:"signature"`
+	Message   string     `json:"message"`
+	Code      string     `json:"code"`
+	Inputs    []Argument `json:"inputs"`
+	Data      string     `json:"data"`
+}
+
+// Parse builds the call tree from the result of the callTracerFinal tracer.
+func Parse(result json.RawMessage) (*Call, error) {
+	var root Call
+	err := json.Unmarshal(result, &root)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing call trace, err: %s", err)
+	}
+
+	return &root, nil
+}
+
+// Walk calls fn for every call of the tree in execution order, together with
+// the external call whose code it runs, which is the call itself unless it is
+// an internal one.
+func (c *Call) Walk(fn func(call *Call, external *Call)) {
+	c.walk(c, fn)
+}
+
+func (c *Call) walk(external *Call, fn func(call *Call, external *Call)) {
+	if c.Type != Internal {
+		external = c
+	}
+	fn(c, external)
+
+	for _, call := range c.Calls {
+		call.walk(external, fn)
+	}
+}
+
+// Failed reports whether the call failed.
+func (c *Call) Failed() bool {
+	return c.Error != ""
+}
+
+// Origin reports whether the call is where a failure originated, rather than
+// one of the calls it was passed up through.
+func (c *Call) Origin() bool {
+	if !c.Failed() {
+		return false
+	}
+	if len(c.Calls) == 0 {
+		return true
+	}
+
+	return !c.Calls[len(c.Calls)-1].Failed()
+}
+
+// GasUsedValue returns the gas used by the call, or zero when it isn't
+// reported.
+func (c *Call) GasUsedValue() uint64 {
+	return parseHex(c.GasUsed).Uint64()
+}
+
+// ValueWei returns the wei transferred by the call.
+func (c *Call) ValueWei() *big.Int {
+	return parseHex(c.Value)
+}
+
+// Selector returns the function selector of the input of an external call,
+// or an empty string if it hasn't one.
+func (c *Call) Selector() string {
+	if c.Type == Internal || len(c.Input) < 10 || !strings.HasPrefix(c.Input, "0x") {
+		return ""
+	}
+
+	if _, ok := new(big.Int).SetString(c.Input[2:10], 16); !ok {
+		return ""
+	}
+
+	return c.Input[:10]
+}
+
+// parseHex parses a 0x prefixed hex quantity, which is zero when it is
+// missing or malformed.
+func parseHex(value string) *big.Int {
+	parsed, ok := new(big.Int).SetString(strings.TrimPrefix(value, "0x"), 16)
+	if !ok || parsed.Sign() < 0 {
+		return new(big.Int)
+	}
+
+	return parsed
+}
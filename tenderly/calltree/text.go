@@ -0,0 +1,289 @@
+package calltree
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ANSI escape codes of the styles the text output is highlighted with.
+const (
+	reset   = "\x1b[0m"
+	bold    = "\x1b[1m"
+	faint   = "\x1b[2m"
+	red     = "\x1b[31m"
+	green   = "\x1b[32m"
+	magenta = "\x1b[35m"
+	cyan    = "\x1b[36m"
+)
+
+// Options configure how a call tree is rendered as text.
+type Options struct {
+	// Depth is the number of levels of calls rendered, the root being the
+	// first one. Deeper calls are summarized. Zero renders all of them.
+	Depth int
+	// Color highlights the output with ANSI escape codes.
+	Color bool
+}
+
+// Render writes the call tree as text, one call per line, nested under its
+// caller. Every call is followed by the events it emitted. The call a
+// failure originated in is highlighted together with the line of source it
+// failed at.
+func Render(w io.Writer, root *Call, opts Options) error {
+	r := &renderer{opts: opts}
+
+	var out bytes.Buffer
+	r.call(root, root, 1).print(&out, "", "")
+
+	_, err := w.Write(out.Bytes())
+	return err
+}
+
+// node is a line of the rendered tree, with the lines continuing it and the
+// nodes nested under it.
+type node struct {
+	lines    []string
+	children []*node
+}
+
+func (n *node) print(out *bytes.Buffer, head string, rest string) {
+	continuation := rest + "   "
+	if len(n.children) > 0 {
+		continuation = rest + "│  "
+	}
+
+	for i, line := range n.lines {
+		if i == 0 {
+			fmt.Fprintf(out, "%s%s\n", head, line)
+			continue
+		}
+		fmt.Fprintf(out, "%s%s\n", continuation, line)
+	}
+
+	for i, child := range n.children {
+		if i == len(n.children)-1 {
+			child.print(out, rest+"└─ ", rest+"   ")
+			continue
+		}
+		child.print(out, rest+"├─ ", rest+"│  ")
+	}
+}
+
+type renderer struct {
+	opts Options
+}
+
+func (r *renderer) paint(style string, text string) string {
+	if !r.opts.Color || text == "" {
+		return text
+	}
+
+	return style + text + reset
+}
+
+func (r *renderer) call(call *Call, external *Call, level int) *node {
+	if call.Type != Internal {
+		external = call
+	}
+
+	n := &node{
+		lines: []string{r.header(call, external)},
+	}
+
+	if r.opts.Depth > 0 && level >= r.opts.Depth && len(call.Calls) > 0 {
+		n.children = append(n.children, r.hidden(call, external))
+	} else {
+		for _, callee := range call.Calls {
+			n.children = append(n.children, r.call(callee, external, level+1))
+		}
+	}
+
+	for _, log := range call.Logs {
+		n.children = append(n.children, &node{
+			lines: []string{r.log(log)},
+		})
+	}
+
+	if call.Origin() && call.Failure != nil {
+		n.children = append(n.children, r.failure(call))
+	}
+
+	return n
+}
+
+// hidden summarizes the calls nested deeper than the rendered levels, keeping
+// the location of a failure which originated in one of them.
+func (r *renderer) hidden(call *Call, external *Call) *node {
+	count := -1
+	var origin, originExternal *Call
+	call.walk(external, func(nested *Call, nestedExternal *Call) {
+		count++
+		if nested != call && nested.Origin() && nested.Failure != nil {
+			origin, originExternal = nested, nestedExternal
+		}
+	})
+
+	noun := "calls"
+	if count == 1 {
+		noun = "call"
+	}
+	n := &node{
+		lines: []string{r.paint(faint, fmt.Sprintf("… %d nested %s", count, noun))},
+	}
+
+	if origin != nil {
+		failure := r.failure(origin)
+		failure.lines[0] += " " + r.paint(faint, "in "+r.name(origin, originExternal))
+		n.children = append(n.children, failure)
+	}
+
+	return n
+}
+
+func (r *renderer) header(call *Call, external *Call) string {
+	var line strings.Builder
+
+	if call.Type != Internal {
+		line.WriteString(r.paint(faint, call.Type) + " ")
+	}
+
+	name := r.name(call, external)
+	if call.Origin() {
+		name = r.paint(bold+red, name)
+	} else {
+		name = r.paint(cyan, name)
+	}
+	line.WriteString(name)
+
+	if call.Func != "" {
+		line.WriteString("(" + r.arguments(call.DecodedInput) + ")")
+	} else if selector := call.Selector(); selector != "" {
+		line.WriteString(r.paint(faint, "."+selector+"(…)"))
+	}
+
+	if call.Failed() {
+		line.WriteString(" " + r.paint(red, "✗ "+r.error(call)))
+	} else if len(call.DecodedOutput) > 0 {
+		line.WriteString(" → (" + r.paint(green, r.arguments(call.DecodedOutput)) + ")")
+	}
+
+	var details []string
+	if value := call.ValueWei(); value.Sign() > 0 {
+		details = append(details, fmt.Sprintf("value %s wei", value))
+	}
+	if call.GasUsed != "" {
+		details = append(details, fmt.Sprintf("gas %d", call.GasUsedValue()))
+	}
+	if len(details) > 0 {
+		line.WriteString(" " + r.paint(faint, "["+strings.Join(details, ", ")+"]"))
+	}
+
+	return line.String()
+}
+
+// name returns the contract and function of the call. Internal calls run the
+// code of the external call they are made in.
+func (r *renderer) name(call *Call, external *Call) string {
+	if call.Type == "SELFDESTRUCT" {
+		return "selfdestruct"
+	}
+
+	contract := external.Contract
+	if contract == "" {
+		contract = external.To
+	}
+	if contract == "" {
+		contract = "<unknown>"
+	}
+
+	if call.Func == "" {
+		return contract
+	}
+
+	return contract + "." + call.Func
+}
+
+func (r *renderer) error(call *Call) string {
+	if call.Error != revertError {
+		return call.Error
+	}
+
+	revert := call.Revert
+	switch {
+	case revert == nil:
+		return "reverted"
+	case revert.Kind == "reason":
+		return fmt.Sprintf("reverted: %q", revert.Message)
+	case revert.Kind == "panic":
+		return fmt.Sprintf("panicked: %s (%s)", revert.Message, revert.Code)
+	case revert.Kind == "custom":
+		return fmt.Sprintf("reverted: %s(%s)", revert.Name, r.arguments(revert.Inputs))
+	}
+
+	return "reverted: " + revert.Data
+}
+
+func (r *renderer) log(log Log) string {
+	if log.Name == "" {
+		event := "anonymous log"
+		if len(log.Topics) > 0 {
+			event = "log " + log.Topics[0]
+		}
+		return "emit " + r.paint(magenta, event)
+	}
+
+	return fmt.Sprintf("emit %s(%s)", r.paint(magenta, log.Name), r.arguments(log.DecodedInputs))
+}
+
+// failure locates the instruction the call failed at, with its line of
+// source.
+func (r *renderer) failure(call *Call) *node {
+	frame := call.Failure
+
+	location := frame.File
+	if frame.Line > 0 {
+		location = fmt.Sprintf("%s:%d:%d", frame.File, frame.Line, frame.Column)
+	}
+
+	n := &node{
+		lines: []string{r.paint(red, "✗ "+location)},
+	}
+	if frame.Text != "" {
+		n.lines = append(n.lines, "  "+r.paint(bold+red, strings.TrimSpace(frame.Text)))
+	}
+
+	return n
+}
+
+func (r *renderer) arguments(arguments []Argument) string {
+	formatted := make([]string, len(arguments))
+	for i, argument := range arguments {
+		value := formatValue(argument)
+		if argument.Name != "" {
+			value = argument.Name + "=" + value
+		}
+		formatted[i] = value
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+// formatValue formats a decoded value. Numbers, addresses and bytes are
+// reported as strings, which are printed bare, while string values keep
+// their quotes.
+func formatValue(argument Argument) string {
+	var value string
+	if argument.Type != "string" && json.Unmarshal(argument.Value, &value) == nil {
+		return value
+	}
+
+	var compact bytes.Buffer
+	if json.Compact(&compact, argument.Value) != nil {
+		return string(argument.Value)
+	}
+
+	return compact.String()
+}
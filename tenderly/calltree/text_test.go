@@ -0,0 +1,125 @@
+package calltree
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/tenderly/tenderly-trace/tenderly/stacktrace"
+)
+
+const testTrace = `{
+	"func": "transfer",
+	"type": "CALL",
+	"from": "0x00000000000000000000000000000000000000aa",
+	"to": "0x00000000000000000000000000000000000000bb",
+	"value": "0x0",
+	"gasUsed": "0x5208",
+	"input": "0xa9059cbb",
+	"decodedInput": [
+		{"name": "to", "type": "address", "value": "0x00000000000000000000000000000000000000cc"},
+		{"name": "amount", "type": "uint256", "value": "5"}
+	],
+	"logs": [
+		{"name": "Attempt", "decodedInputs": [{"name": "note", "type": "string", "value": "hi"}]}
+	],
+	"error": "execution reverted",
+	"errorPC": 90,
+	"calls": [
+		{
+			"func": "_move",
+			"type": "JUMPDEST",
+			"to": "0x00000000000000000000000000000000000000bb",
+			"gasUsed": "0x64",
+			"decodedInput": [{"name": "amount", "type": "uint256", "value": "5"}],
+			"calls": [
+				{
+					"func": "balanceOf",
+					"type": "STATICCALL",
+					"to": "0x00000000000000000000000000000000000000dd",
+					"gasUsed": "0x10",
+					"decodedOutput": [{"name": "", "type": "uint256", "value": "3"}]
+				}
+			]
+		},
+		{
+			"func": "_check",
+			"type": "JUMPDEST",
+			"to": "0x00000000000000000000000000000000000000bb",
+			"error": "execution reverted",
+			"errorPC": 80,
+			"revert": {"kind": "reason", "message": "insufficient balance", "data": "0x08c379a0"}
+		}
+	]
+}`
+
+func testTree(t *testing.T) *Call {
+	root, err := Parse([]byte(testTrace))
+	if err != nil {
+		t.Fatalf("failed parsing trace: %s", err)
+	}
+
+	root.Contract = "Token"
+	root.Calls[0].Calls[0].Contract = "Ledger"
+	root.Calls[1].Failure = &stacktrace.Frame{
+		File:   "contracts/Token.sol",
+		Line:   42,
+		Column: 9,
+		Text:   `require(balance >= amount, "insufficient balance");`,
+	}
+
+	return root
+}
+
+func render(t *testing.T, root *Call, opts Options) string {
+	var out bytes.Buffer
+	err := Render(&out, root, opts)
+	if err != nil {
+		t.Fatalf("failed rendering: %s", err)
+	}
+
+	return out.String()
+}
+
+func TestRender(t *testing.T) {
+	expected := strings.Join([]string{
+		`CALL Token.transfer(to=0x00000000000000000000000000000000000000cc, amount=5) ✗ reverted [gas 21000]`,
+		`├─ Token._move(amount=5) [gas 100]`,
+		`│  └─ STATICCALL Ledger.balanceOf() → (3) [gas 16]`,
+		`├─ Token._check() ✗ reverted: "insufficient balance"`,
+		`│  └─ ✗ contracts/Token.sol:42:9`,
+		`│          require(balance >= amount, "insufficient balance");`,
+		`└─ emit Attempt(note="hi")`,
+		``,
+	}, "\n")
+
+	if out := render(t, testTree(t), Options{}); out != expected {
+		t.Errorf("unexpected output:\n%s\nexpected:\n%s", out, expected)
+	}
+}
+
+func TestRenderDepth(t *testing.T) {
+	expected := strings.Join([]string{
+		`CALL Token.transfer(to=0x00000000000000000000000000000000000000cc, amount=5) ✗ reverted [gas 21000]`,
+		`├─ … 3 nested calls`,
+		`│  └─ ✗ contracts/Token.sol:42:9 in Token._check`,
+		`│          require(balance >= amount, "insufficient balance");`,
+		`└─ emit Attempt(note="hi")`,
+		``,
+	}, "\n")
+
+	if out := render(t, testTree(t), Options{Depth: 1}); out != expected {
+		t.Errorf("unexpected output:\n%s\nexpected:\n%s", out, expected)
+	}
+}
+
+func TestRenderColor(t *testing.T) {
+	out := render(t, testTree(t), Options{Color: true})
+
+	if !strings.Contains(out, bold+red+"Token._check"+reset) {
+		t.Errorf("expected the reverting call highlighted, got:\n%s", out)
+	}
+	if strings.Contains(render(t, testTree(t), Options{}), "\x1b[") {
+		t.Errorf("expected no escape codes without color")
+	}
+}
@@ -0,0 +1,19 @@
+package tenderly
+
+import (
+	"github.com/tenderly/tenderly-trace/source"
+	"github.com/tenderly/tenderly-trace/tenderly/debugger"
+)
+
+// Debug re-executes the transaction and records it, returning a debugger
+// which steps through it line by line in the sources of the given source.
+func (t Tenderly) Debug(txHash string, cs source.Source) (*debugger.Debugger, error) {
+	recorder := debugger.NewRecorder(cs.GetSource())
+
+	_, err := t.replay(txHash, cs, recorder)
+	if err != nil {
+		return nil, err
+	}
+
+	return recorder.Debugger(), nil
+}
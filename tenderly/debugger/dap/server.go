@@ -0,0 +1,487 @@
+// Package dap serves the debugger over the Debug Adapter Protocol, so editors
+// like VS Code can step through a transaction in the sources of its project.
+package dap
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/tenderly/tenderly-trace/tenderly/debugger"
+)
+
+// threadId is the id of the only thread, the transaction.
+const threadId = 1
+
+// Launcher records the transaction named by the launch arguments and returns
+// a debugger over it.
+type Launcher func(args LaunchArguments) (*debugger.Debugger, error)
+
+// Server is a debug adapter serving a single debugging session.
+type Server struct {
+	reader *bufio.Reader
+	writer io.Writer
+	launch Launcher
+	seq    int
+
+	debugger *debugger.Debugger
+	root     string
+	// breakpoints holds the ids of the breakpoints set in every source, which
+	// the client always sets all at once.
+	breakpoints map[string][]int
+	// handles holds the values variables references point to. They are only
+	// valid until the debugger moves.
+	handles     []interface{}
+	stopOnEntry bool
+	// failed is set once the failure of the transaction is reported.
+	failed bool
+}
+
+// NewServer creates a server reading requests from in and writing responses
+// and events to out.
+func NewServer(in io.Reader, out io.Writer, launch Launcher) *Server {
+	return &Server{
+		reader:      bufio.NewReader(in),
+		writer:      out,
+		launch:      launch,
+		breakpoints: make(map[string][]int),
+	}
+}
+
+// Serve handles requests until the client disconnects or the input ends.
+func (s *Server) Serve() error {
+	for {
+		data, err := readMessage(s.reader)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed reading dap message, err: %s", err)
+		}
+
+		var req request
+		err = json.Unmarshal(data, &req)
+		if err != nil {
+			return fmt.Errorf("failed parsing dap message, err: %s", err)
+		}
+		if req.Type != "request" {
+			continue
+		}
+
+		done, err := s.handle(&req)
+		if err != nil {
+			return fmt.Errorf("failed writing dap message, err: %s", err)
+		}
+		if done {
+			return nil
+		}
+	}
+}
+
+// handle answers the request and reports whether the session is over.
+func (s *Server) handle(req *request) (bool, error) {
+	if req.Command == "disconnect" {
+		return true, s.respond(req, nil)
+	}
+
+	if s.debugger == nil && req.Command != "initialize" && req.Command != "launch" {
+		return false, s.fail(req, "no transaction launched")
+	}
+
+	switch req.Command {
+	case "initialize":
+		return false, s.respond(req, &capabilities{
+			SupportsConfigurationDoneRequest: true,
+			SupportsStepBack:                 true,
+		})
+	case "launch":
+		return false, s.handleLaunch(req)
+	case "setBreakpoints":
+		return false, s.handleSetBreakpoints(req)
+	case "configurationDone":
+		return false, s.handleConfigurationDone(req)
+	case "threads":
+		return false, s.respond(req, map[string]interface{}{
+			"threads": []thread{{Id: threadId, Name: "transaction"}},
+		})
+	case "stackTrace":
+		return false, s.handleStackTrace(req)
+	case "scopes":
+		return false, s.handleScopes(req)
+	case "variables":
+		return false, s.handleVariables(req)
+	case "continue":
+		return false, s.move(req, func() string {
+			if s.debugger.Continue() != nil {
+				return "breakpoint"
+			}
+			return ""
+		})
+	case "reverseContinue":
+		return false, s.move(req, func() string {
+			if s.debugger.ReverseContinue() != nil {
+				return "breakpoint"
+			}
+			return "entry"
+		})
+	case "next":
+		return false, s.step(req, s.debugger.StepOver)
+	case "stepIn":
+		return false, s.step(req, s.debugger.StepInto)
+	case "stepOut":
+		return false, s.step(req, s.debugger.StepOut)
+	case "stepBack":
+		return false, s.move(req, func() string {
+			if s.debugger.StepBack() && s.debugger.Breakpoint() != nil {
+				return "breakpoint"
+			}
+			return "step"
+		})
+	}
+
+	return false, s.fail(req, fmt.Sprintf("unsupported command %s", req.Command))
+}
+
+func (s *Server) handleLaunch(req *request) error {
+	var args LaunchArguments
+	err := json.Unmarshal(req.Arguments, &args)
+	if err != nil {
+		return s.fail(req, fmt.Sprintf("invalid launch arguments: %s", err))
+	}
+
+	d, err := s.launch(args)
+	if err != nil {
+		return s.fail(req, err.Error())
+	}
+	if d.Current() == nil {
+		return s.fail(req, "the transaction executed no code with known sources")
+	}
+
+	s.debugger = d
+	s.root = args.Project
+	s.stopOnEntry = args.StopOnEntry
+
+	err = s.respond(req, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.send("initialized", nil)
+}
+
+func (s *Server) handleSetBreakpoints(req *request) error {
+	var args setBreakpointsArguments
+	err := json.Unmarshal(req.Arguments, &args)
+	if err != nil {
+		return s.fail(req, fmt.Sprintf("invalid breakpoints: %s", err))
+	}
+
+	for _, id := range s.breakpoints[args.Source.Path] {
+		s.debugger.ClearBreakpoint(id)
+	}
+	s.breakpoints[args.Source.Path] = nil
+
+	file := s.file(args.Source.Path)
+	breakpoints := []breakpoint{}
+	for _, requested := range args.Breakpoints {
+		set := s.debugger.SetBreakpoint(file, requested.Line)
+		s.breakpoints[args.Source.Path] = append(s.breakpoints[args.Source.Path], set.Id)
+
+		breakpoints = append(breakpoints, breakpoint{
+			Id:       set.Id,
+			Verified: s.debugger.Verified(set),
+			Line:     requested.Line,
+			Source:   &args.Source,
+		})
+	}
+
+	return s.respond(req, map[string]interface{}{
+		"breakpoints": breakpoints,
+	})
+}
+
+// handleConfigurationDone starts the execution, which stops at the first
+// breakpoint. Launching with stopOnEntry stops at the first line instead.
+func (s *Server) handleConfigurationDone(req *request) error {
+	err := s.respond(req, nil)
+	if err != nil {
+		return err
+	}
+
+	if s.stopOnEntry {
+		return s.stopped("entry")
+	}
+	if s.debugger.Breakpoint() != nil {
+		return s.stopped("breakpoint")
+	}
+	if s.debugger.Continue() != nil {
+		return s.stopped("breakpoint")
+	}
+
+	return s.end()
+}
+
+func (s *Server) handleStackTrace(req *request) error {
+	stack := s.debugger.Current().CallStack
+
+	frames := []stackFrame{}
+	for i := len(stack) - 1; i >= 0; i-- {
+		frame := stackFrame{
+			Id:   i,
+			Name: stack[i].Contract + "." + stack[i].Function,
+		}
+		if location := stack[i].Location; location != nil {
+			frame.Source = s.sourceFor(location.File)
+			frame.Line = location.Line
+			frame.Column = location.Column
+		}
+
+		frames = append(frames, frame)
+	}
+
+	return s.respond(req, map[string]interface{}{
+		"stackFrames": frames,
+		"totalFrames": len(frames),
+	})
+}
+
+// handleScopes lists the scopes of the innermost frame, which is the only one
+// whose variables are recorded.
+func (s *Server) handleScopes(req *request) error {
+	var args scopesArguments
+	err := json.Unmarshal(req.Arguments, &args)
+	if err != nil {
+		return s.fail(req, fmt.Sprintf("invalid scopes arguments: %s", err))
+	}
+
+	current := s.debugger.Current()
+	scopes := []scope{}
+	if args.FrameId == current.Level()-1 {
+		locals := object{}
+		for _, local := range current.Locals {
+			locals = append(locals, field{name: local.Name, typ: local.Type, value: tree(local.Value)})
+		}
+
+		state := object{}
+		for _, variable := range current.StateVariables {
+			state = append(state, field{name: variable.Name, typ: variable.Type, value: tree(variable.Value)})
+		}
+
+		stack := object{}
+		for i := len(current.Stack) - 1; i >= 0; i-- {
+			stack = append(stack, field{name: fmt.Sprint(len(current.Stack) - 1 - i), value: hexutil.EncodeBig(current.Stack[i])})
+		}
+
+		memory := object{}
+		for offset := 0; offset < len(current.Memory); offset += 32 {
+			end := offset + 32
+			if end > len(current.Memory) {
+				end = len(current.Memory)
+			}
+			memory = append(memory, field{name: fmt.Sprintf("%#06x", offset), value: hex.EncodeToString(current.Memory[offset:end])})
+		}
+
+		storage := object{}
+		for slot, value := range current.Storage {
+			storage = append(storage, field{name: slot.Hex(), value: value.Hex()})
+		}
+		storage.sort()
+
+		scopes = append(scopes,
+			scope{Name: "Locals", VariablesReference: s.reference(locals)},
+			scope{Name: "State", VariablesReference: s.reference(state)},
+			scope{Name: "Stack", VariablesReference: s.reference(stack), Expensive: true},
+			scope{Name: "Memory", VariablesReference: s.reference(memory), Expensive: true},
+			scope{Name: "Storage", VariablesReference: s.reference(storage), Expensive: true},
+		)
+	}
+
+	return s.respond(req, map[string]interface{}{
+		"scopes": scopes,
+	})
+}
+
+func (s *Server) handleVariables(req *request) error {
+	var args variablesArguments
+	err := json.Unmarshal(req.Arguments, &args)
+	if err != nil {
+		return s.fail(req, fmt.Sprintf("invalid variables arguments: %s", err))
+	}
+	if args.VariablesReference < 1 || args.VariablesReference > len(s.handles) {
+		return s.fail(req, fmt.Sprintf("unknown variables reference %d", args.VariablesReference))
+	}
+
+	variables := []variable{}
+	switch value := s.handles[args.VariablesReference-1].(type) {
+	case object:
+		for _, f := range value {
+			variables = append(variables, s.variable(f.name, f.typ, f.value))
+		}
+	case []interface{}:
+		for i, element := range value {
+			variables = append(variables, s.variable(fmt.Sprint(i), "", element))
+		}
+	}
+
+	return s.respond(req, map[string]interface{}{
+		"variables": variables,
+	})
+}
+
+// step moves the debugger, ending the session when there is nowhere to go.
+func (s *Server) step(req *request, move func() bool) error {
+	return s.move(req, func() string {
+		if !move() {
+			return ""
+		}
+		if s.debugger.Breakpoint() != nil {
+			return "breakpoint"
+		}
+		return "step"
+	})
+}
+
+// move answers the request and reports where the debugger stopped. Moves
+// which stop nowhere have run to the end of the execution.
+func (s *Server) move(req *request, move func() string) error {
+	err := s.respond(req, nil)
+	if err != nil {
+		return err
+	}
+
+	reason := move()
+	if reason == "" {
+		return s.end()
+	}
+
+	return s.stopped(reason)
+}
+
+// end reports the end of the execution. The failure of the transaction is
+// reported as an exception at its last line before the session terminates.
+func (s *Server) end() error {
+	if err := s.debugger.Err(); err != nil && !s.failed {
+		s.failed = true
+		s.debugger.Goto(len(s.debugger.Stops()) - 1)
+		s.handles = nil
+
+		return s.send("stopped", &stoppedEvent{
+			Reason:            "exception",
+			Description:       "Transaction failed",
+			Text:              err.Error(),
+			ThreadId:          threadId,
+			AllThreadsStopped: true,
+		})
+	}
+
+	err := s.send("terminated", nil)
+	if err != nil {
+		return err
+	}
+
+	return s.send("exited", map[string]int{"exitCode": 0})
+}
+
+func (s *Server) stopped(reason string) error {
+	s.handles = nil
+
+	return s.send("stopped", &stoppedEvent{
+		Reason:            reason,
+		ThreadId:          threadId,
+		AllThreadsStopped: true,
+	})
+}
+
+// file returns the path the source maps refer to the file by, relative to
+// the project root for files inside it.
+func (s *Server) file(path string) string {
+	if s.root == "" || !filepath.IsAbs(path) {
+		return path
+	}
+
+	relative, err := filepath.Rel(s.root, path)
+	if err != nil || strings.HasPrefix(relative, "..") {
+		return path
+	}
+
+	return filepath.ToSlash(relative)
+}
+
+// sourceFor returns the source the client opens for the file of a location.
+func (s *Server) sourceFor(file string) *source {
+	path := file
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(s.root, filepath.FromSlash(path))
+	}
+
+	return &source{
+		Name: filepath.Base(file),
+		Path: path,
+	}
+}
+
+func (s *Server) variable(name string, typ string, value interface{}) variable {
+	v := variable{
+		Name: name,
+		Type: typ,
+	}
+
+	switch value := value.(type) {
+	case object:
+		v.Value = "{…}"
+		v.VariablesReference = s.reference(value)
+	case []interface{}:
+		v.Value = fmt.Sprintf("[%d]", len(value))
+		v.VariablesReference = s.reference(value)
+	case nil:
+		v.Value = "null"
+	default:
+		v.Value = fmt.Sprint(value)
+	}
+
+	return v
+}
+
+func (s *Server) reference(value interface{}) int {
+	s.handles = append(s.handles, value)
+	return len(s.handles)
+}
+
+func (s *Server) respond(req *request, body interface{}) error {
+	s.seq++
+	return writeMessage(s.writer, &response{
+		Seq:        s.seq,
+		Type:       "response",
+		RequestSeq: req.Seq,
+		Success:    true,
+		Command:    req.Command,
+		Body:       body,
+	})
+}
+
+func (s *Server) fail(req *request, message string) error {
+	s.seq++
+	return writeMessage(s.writer, &response{
+		Seq:        s.seq,
+		Type:       "response",
+		RequestSeq: req.Seq,
+		Success:    false,
+		Command:    req.Command,
+		Message:    message,
+	})
+}
+
+func (s *Server) send(name string, body interface{}) error {
+	s.seq++
+	return writeMessage(s.writer, &event{
+		Seq:   s.seq,
+		Type:  "event",
+		Event: name,
+		Body:  body,
+	})
+}
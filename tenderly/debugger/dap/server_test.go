@@ -0,0 +1,256 @@
+package dap
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/tenderly/tenderly-trace/tenderly/debugger"
+)
+
+type message struct {
+	Type    string          `json:"type"`
+	Command string          `json:"command"`
+	Event   string          `json:"event"`
+	Success bool            `json:"success"`
+	Message string          `json:"message"`
+	Body    json.RawMessage `json:"body"`
+}
+
+// client is a scripted debug adapter client.
+type client struct {
+	t      *testing.T
+	reader *bufio.Reader
+	writer io.Writer
+	seq    int
+}
+
+func (c *client) request(command string, arguments interface{}, body interface{}) {
+	c.t.Helper()
+
+	c.seq++
+	data, err := json.Marshal(map[string]interface{}{
+		"seq":       c.seq,
+		"type":      "request",
+		"command":   command,
+		"arguments": arguments,
+	})
+	if err != nil {
+		c.t.Fatalf("failed encoding request: %s", err)
+	}
+
+	err = writeMessage(c.writer, json.RawMessage(data))
+	if err != nil {
+		c.t.Fatalf("failed writing request: %s", err)
+	}
+
+	response := c.read()
+	if response.Type != "response" || response.Command != command {
+		c.t.Fatalf("expected %s response, got %+v", command, response)
+	}
+	if !response.Success {
+		c.t.Fatalf("%s failed: %s", command, response.Message)
+	}
+	if body != nil {
+		err = json.Unmarshal(response.Body, body)
+		if err != nil {
+			c.t.Fatalf("failed decoding %s response: %s", command, err)
+		}
+	}
+}
+
+func (c *client) event(name string, body interface{}) {
+	c.t.Helper()
+
+	event := c.read()
+	if event.Type != "event" || event.Event != name {
+		c.t.Fatalf("expected %s event, got %+v", name, event)
+	}
+	if body != nil {
+		err := json.Unmarshal(event.Body, body)
+		if err != nil {
+			c.t.Fatalf("failed decoding %s event: %s", name, err)
+		}
+	}
+}
+
+func (c *client) read() *message {
+	c.t.Helper()
+
+	data, err := readMessage(c.reader)
+	if err != nil {
+		c.t.Fatalf("failed reading message: %s", err)
+	}
+
+	var m message
+	err = json.Unmarshal(data, &m)
+	if err != nil {
+		c.t.Fatalf("failed decoding message: %s", err)
+	}
+
+	return &m
+}
+
+func (c *client) top() stackFrame {
+	c.t.Helper()
+
+	var trace struct {
+		StackFrames []stackFrame `json:"stackFrames"`
+	}
+	c.request("stackTrace", map[string]int{"threadId": threadId}, &trace)
+
+	return trace.StackFrames[0]
+}
+
+func (c *client) stopped(reason string, line int) {
+	c.t.Helper()
+
+	var stopped stoppedEvent
+	c.event("stopped", &stopped)
+	if stopped.Reason != reason {
+		c.t.Errorf("expected to stop on %s, stopped on %s", reason, stopped.Reason)
+	}
+	if top := c.top(); top.Line != line {
+		c.t.Errorf("expected to stop at line %d, stopped at %d", line, top.Line)
+	}
+}
+
+func frames(lines []int, functions ...string) []*debugger.Frame {
+	var stack []*debugger.Frame
+	for i, function := range functions {
+		stack = append(stack, &debugger.Frame{
+			Contract: "Token",
+			Function: function,
+			Location: &debugger.Location{File: "contracts/Token.sol", Line: lines[i], Column: 5},
+		})
+	}
+
+	return stack
+}
+
+func testDebugger() *debugger.Debugger {
+	stops := []*debugger.Stop{
+		{CallStack: frames([]int{10}, "transfer"), Locals: []*debugger.Variable{
+			{Name: "amount", Type: "uint256", Value: big.NewInt(5)},
+		}},
+		{CallStack: frames([]int{11}, "transfer")},
+		{CallStack: frames([]int{11, 20}, "transfer", "_move"), Locals: []*debugger.Variable{
+			{Name: "balance", Type: "struct Token.Balance", Value: json.RawMessage(`{"owner":"0xab","amount":7}`)},
+		}},
+		{CallStack: frames([]int{11, 21}, "transfer", "_move")},
+		{CallStack: frames([]int{12}, "transfer")},
+	}
+	for i, stop := range stops {
+		stop.Index = i
+		stop.Location = *stop.CallStack[len(stop.CallStack)-1].Location
+	}
+
+	return debugger.New(stops, nil, nil)
+}
+
+func TestServer(t *testing.T) {
+	requests, requestWriter := io.Pipe()
+	responseReader, responses := io.Pipe()
+
+	server := NewServer(requests, responses, func(args LaunchArguments) (*debugger.Debugger, error) {
+		return testDebugger(), nil
+	})
+	served := make(chan error)
+	go func() {
+		served <- server.Serve()
+	}()
+
+	c := &client{
+		t:      t,
+		reader: bufio.NewReader(responseReader),
+		writer: requestWriter,
+	}
+
+	var capabilities capabilities
+	c.request("initialize", map[string]string{"adapterID": "tenderly"}, &capabilities)
+	if !capabilities.SupportsStepBack {
+		t.Errorf("expected step back support")
+	}
+
+	c.request("launch", &LaunchArguments{TxHash: "0x01", Project: "/project"}, nil)
+	c.event("initialized", nil)
+
+	var set struct {
+		Breakpoints []breakpoint `json:"breakpoints"`
+	}
+	c.request("setBreakpoints", map[string]interface{}{
+		"source":      map[string]string{"path": "/project/contracts/Token.sol"},
+		"breakpoints": []map[string]int{{"line": 20}, {"line": 30}},
+	}, &set)
+	if len(set.Breakpoints) != 2 || !set.Breakpoints[0].Verified || set.Breakpoints[1].Verified {
+		t.Errorf("expected only the breakpoint on line 20 verified, got %+v", set.Breakpoints)
+	}
+
+	c.request("configurationDone", nil, nil)
+	c.stopped("breakpoint", 20)
+
+	var trace struct {
+		StackFrames []stackFrame `json:"stackFrames"`
+	}
+	c.request("stackTrace", map[string]int{"threadId": threadId}, &trace)
+	if len(trace.StackFrames) != 2 {
+		t.Fatalf("expected 2 frames, got %d", len(trace.StackFrames))
+	}
+	if top := trace.StackFrames[0]; top.Name != "Token._move" || top.Source == nil || top.Source.Path != "/project/contracts/Token.sol" {
+		t.Errorf("unexpected top frame %+v", top)
+	}
+	if caller := trace.StackFrames[1]; caller.Name != "Token.transfer" || caller.Line != 11 {
+		t.Errorf("unexpected caller frame %+v", caller)
+	}
+
+	var scopes struct {
+		Scopes []scope `json:"scopes"`
+	}
+	c.request("scopes", map[string]int{"frameId": trace.StackFrames[0].Id}, &scopes)
+	if len(scopes.Scopes) == 0 || scopes.Scopes[0].Name != "Locals" {
+		t.Fatalf("expected the locals scope first, got %+v", scopes.Scopes)
+	}
+
+	var locals struct {
+		Variables []variable `json:"variables"`
+	}
+	c.request("variables", map[string]int{"variablesReference": scopes.Scopes[0].VariablesReference}, &locals)
+	if len(locals.Variables) != 1 || locals.Variables[0].Name != "balance" || locals.Variables[0].VariablesReference == 0 {
+		t.Fatalf("expected the balance struct, got %+v", locals.Variables)
+	}
+
+	var members struct {
+		Variables []variable `json:"variables"`
+	}
+	c.request("variables", map[string]int{"variablesReference": locals.Variables[0].VariablesReference}, &members)
+	if len(members.Variables) != 2 || members.Variables[0].Name != "owner" || members.Variables[1].Value != "7" {
+		t.Errorf("expected the struct members in order, got %+v", members.Variables)
+	}
+
+	c.request("next", map[string]int{"threadId": threadId}, nil)
+	c.stopped("step", 21)
+
+	c.request("stepOut", map[string]int{"threadId": threadId}, nil)
+	c.stopped("step", 12)
+
+	// Stepping back over the call stops at the breakpoint inside it.
+	c.request("stepBack", map[string]int{"threadId": threadId}, nil)
+	c.stopped("breakpoint", 20)
+
+	c.request("reverseContinue", map[string]int{"threadId": threadId}, nil)
+	c.stopped("entry", 10)
+
+	c.request("continue", map[string]int{"threadId": threadId}, nil)
+	c.stopped("breakpoint", 20)
+
+	c.request("continue", map[string]int{"threadId": threadId}, nil)
+	c.event("terminated", nil)
+	c.event("exited", nil)
+
+	c.request("disconnect", nil, nil)
+	if err := <-served; err != nil {
+		t.Errorf("unexpected serve error: %s", err)
+	}
+}
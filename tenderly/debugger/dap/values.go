@@ -0,0 +1,88 @@
+package dap
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// field is a named value of an object, with the type of the variable it holds
+// when known.
+type field struct {
+	name  string
+	typ   string
+	value interface{}
+}
+
+// object is a value whose fields are listed in order, like a decoded struct.
+type object []field
+
+func (o object) sort() {
+	sort.Slice(o, func(i, j int) bool {
+		return o[i].name < o[j].name
+	})
+}
+
+// tree converts a decoded value into objects, arrays and scalars the client
+// can expand. Values are converted through their JSON encoding, which keeps
+// the order of struct members.
+func tree(value interface{}) interface{} {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	converted, err := decodeTree(decoder)
+	if err != nil {
+		return nil
+	}
+
+	return converted
+}
+
+func decodeTree(decoder *json.Decoder) (interface{}, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		o := object{}
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := decodeTree(decoder)
+			if err != nil {
+				return nil, err
+			}
+
+			name, _ := key.(string)
+			o = append(o, field{name: name, value: value})
+		}
+
+		_, err = decoder.Token()
+		return o, err
+	case json.Delim('['):
+		array := []interface{}{}
+		for decoder.More() {
+			value, err := decodeTree(decoder)
+			if err != nil {
+				return nil, err
+			}
+
+			array = append(array, value)
+		}
+
+		_, err = decoder.Token()
+		return array, err
+	}
+
+	return token, nil
+}
@@ -0,0 +1,234 @@
+package debugger
+
+import "strings"
+
+// Breakpoint stops the debugger at the start of a source line. Files match by
+// their path or by its trailing elements, so Token.sol matches
+// contracts/Token.sol.
+type Breakpoint struct {
+	Id   int    `json:"id"`
+	File string `json:"file"`
+	Line int    `json:"line"`
+}
+
+func (b *Breakpoint) matches(location Location) bool {
+	if location.Line != b.Line {
+		return false
+	}
+
+	return location.File == b.File || strings.HasSuffix(location.File, "/"+b.File)
+}
+
+// Debugger steps through a recorded execution. It starts at its first stop,
+// and every move returns false without moving when there is nowhere to go.
+type Debugger struct {
+	stops    []*Stop
+	position int
+
+	breakpoints []*Breakpoint
+	nextId      int
+
+	output []byte
+	err    error
+}
+
+// New creates a debugger over the stops of an execution, which returned the
+// given output and error.
+func New(stops []*Stop, output []byte, err error) *Debugger {
+	return &Debugger{
+		stops:  stops,
+		output: output,
+		err:    err,
+		nextId: 1,
+	}
+}
+
+// Stops returns all stops of the execution.
+func (d *Debugger) Stops() []*Stop {
+	return d.stops
+}
+
+// Current returns the stop the debugger is at, or nil if the execution never
+// reached a known source line.
+func (d *Debugger) Current() *Stop {
+	if len(d.stops) == 0 {
+		return nil
+	}
+
+	return d.stops[d.position]
+}
+
+// Output returns the data the execution returned.
+func (d *Debugger) Output() []byte {
+	return d.output
+}
+
+// Err returns the error the execution failed with.
+func (d *Debugger) Err() error {
+	return d.err
+}
+
+// AtEnd reports whether the debugger is at the last stop of the execution.
+func (d *Debugger) AtEnd() bool {
+	return d.position >= len(d.stops)-1
+}
+
+// Goto moves to the stop with the given index.
+func (d *Debugger) Goto(index int) bool {
+	if index < 0 || index >= len(d.stops) {
+		return false
+	}
+
+	d.position = index
+	return true
+}
+
+// StepInto moves to the next line executed, entering called functions.
+func (d *Debugger) StepInto() bool {
+	return d.seek(1, func(s *Stop) bool {
+		return true
+	})
+}
+
+// StepOver moves to the next line of the current function, or of its caller
+// when it returns. Breakpoints in called functions stop it.
+func (d *Debugger) StepOver() bool {
+	level := d.level()
+	return d.seek(1, func(s *Stop) bool {
+		return s.Level() <= level || d.hit(s) != nil
+	})
+}
+
+// StepOut moves to the next line of the caller of the current function.
+// Breakpoints on the way stop it.
+func (d *Debugger) StepOut() bool {
+	level := d.level()
+	return d.seek(1, func(s *Stop) bool {
+		return s.Level() < level || d.hit(s) != nil
+	})
+}
+
+// StepBack moves to the previous line of the current function, or of its
+// caller when the function was just entered. Breakpoints in called functions
+// stop it.
+func (d *Debugger) StepBack() bool {
+	level := d.level()
+	return d.seek(-1, func(s *Stop) bool {
+		return s.Level() <= level || d.hit(s) != nil
+	})
+}
+
+// Continue moves to the next breakpoint, or to the end of the execution if
+// there are no more. It returns the breakpoint it stopped at, if any.
+func (d *Debugger) Continue() *Breakpoint {
+	return d.run(1)
+}
+
+// ReverseContinue moves to the previous breakpoint, or to the start of the
+// execution if there are none. It returns the breakpoint it stopped at, if
+// any.
+func (d *Debugger) ReverseContinue() *Breakpoint {
+	return d.run(-1)
+}
+
+// SetBreakpoint adds a breakpoint on the source line.
+func (d *Debugger) SetBreakpoint(file string, line int) *Breakpoint {
+	breakpoint := &Breakpoint{
+		Id:   d.nextId,
+		File: file,
+		Line: line,
+	}
+	d.nextId++
+
+	d.breakpoints = append(d.breakpoints, breakpoint)
+	return breakpoint
+}
+
+// ClearBreakpoint removes the breakpoint with the given id.
+func (d *Debugger) ClearBreakpoint(id int) bool {
+	for i, breakpoint := range d.breakpoints {
+		if breakpoint.Id == id {
+			d.breakpoints = append(d.breakpoints[:i], d.breakpoints[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Breakpoints returns the breakpoints in the order they were added.
+func (d *Debugger) Breakpoints() []*Breakpoint {
+	return d.breakpoints
+}
+
+// Breakpoint returns the breakpoint the debugger is at, if any.
+func (d *Debugger) Breakpoint() *Breakpoint {
+	current := d.Current()
+	if current == nil {
+		return nil
+	}
+
+	return d.hit(current)
+}
+
+// Verified reports whether the execution stops at the breakpoint at all.
+func (d *Debugger) Verified(breakpoint *Breakpoint) bool {
+	for _, s := range d.stops {
+		if breakpoint.matches(s.Location) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *Debugger) level() int {
+	current := d.Current()
+	if current == nil {
+		return 0
+	}
+
+	return current.Level()
+}
+
+// seek moves in the given direction to the first stop accepted by the
+// predicate.
+func (d *Debugger) seek(direction int, accept func(s *Stop) bool) bool {
+	for i := d.position + direction; i >= 0 && i < len(d.stops); i += direction {
+		if accept(d.stops[i]) {
+			d.position = i
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *Debugger) run(direction int) *Breakpoint {
+	var breakpoint *Breakpoint
+	found := d.seek(direction, func(s *Stop) bool {
+		breakpoint = d.hit(s)
+		return breakpoint != nil
+	})
+	if found {
+		return breakpoint
+	}
+
+	if direction > 0 {
+		d.Goto(len(d.stops) - 1)
+	} else {
+		d.Goto(0)
+	}
+	return nil
+}
+
+// hit returns the breakpoint the stop is at, if any.
+func (d *Debugger) hit(s *Stop) *Breakpoint {
+	for _, breakpoint := range d.breakpoints {
+		if breakpoint.matches(s.Location) {
+			return breakpoint
+		}
+	}
+
+	return nil
+}
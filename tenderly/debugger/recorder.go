@@ -0,0 +1,462 @@
+// Package debugger steps through a re-executed transaction at the granularity
+// of Solidity source lines. The execution is recorded before it is debugged,
+// so the debugger moves backwards as easily as forwards.
+package debugger
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tenderly/tenderly-trace/ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
+	"github.com/tenderly/tenderly-trace/ethereum/eth/tracers"
+	"github.com/tenderly/tenderly-trace/source"
+)
+
+// UnknownFunction names the code which couldn't be attributed to a function,
+// like the function dispatcher.
+const UnknownFunction = "<unknown>"
+
+// Location is a position in a source file, lines and columns counted from one.
+type Location struct {
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+}
+
+// Frame is a function call in progress, external or internal. Location is
+// the line the function is executing, which is the call site for all frames
+// but the innermost one.
+type Frame struct {
+	Contract string         `json:"contract"`
+	Address  common.Address `json:"address"`
+	Function string         `json:"function"`
+	Location *Location      `json:"location,omitempty"`
+}
+
+// Variable is a local variable decoded from the stack, memory or calldata.
+type Variable struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// Stop is the state of the execution at the start of a source line, which is
+// where the debugger can stop.
+type Stop struct {
+	Index int `json:"index"`
+	// Step is the number of opcodes executed before the stop.
+	Step  int       `json:"step"`
+	Pc    uint64    `json:"pc"`
+	Op    vm.OpCode `json:"op"`
+	Gas   uint64    `json:"gas"`
+	Depth int       `json:"depth"`
+
+	Location  Location `json:"location"`
+	CallStack []*Frame `json:"callStack"`
+
+	Stack   []*big.Int                  `json:"stack"`
+	Memory  []byte                      `json:"memory"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+
+	Locals         []*Variable                `json:"locals"`
+	StateVariables []*tracers.StorageVariable `json:"stateVariables"`
+
+	// Error is set when the execution of the line fails.
+	Error string `json:"error,omitempty"`
+}
+
+// Level is the number of function calls in progress at the stop, internal
+// function calls included.
+func (s *Stop) Level() int {
+	return len(s.CallStack)
+}
+
+// local is a variable of a function call, located by the absolute index of
+// its topmost stack slot. It goes out of scope once the stack shrinks below
+// it.
+type local struct {
+	id         int
+	name       string
+	typ        string
+	identifier string
+	index      int
+}
+
+// function is an internal function call in progress.
+type function struct {
+	name     string
+	location *Location
+	locals   []*local
+	// pending holds the variables declared by the previous opcode, which are
+	// placed on the stack once it is executed.
+	pending []*types.Node
+}
+
+// frame is an external call in progress.
+type frame struct {
+	contract  string
+	address   common.Address
+	sourceMap source.SourceMap
+	functions []*function
+	// entering is set by a jump into a function, the following JUMPDEST names it.
+	entering bool
+}
+
+type contractInfo struct {
+	name      string
+	sourceMap source.SourceMap
+}
+
+type stateKey struct {
+	address  common.Address
+	codeHash common.Hash
+}
+
+// Recorder is a vm.Tracer which records the state of the execution at the
+// start of every source line it executes, to be stepped through by a
+// Debugger afterwards.
+//
+// Internal function calls are tracked through the jump markers of the source
+// map. Their variables are located on the stack from the parameters of the
+// function and the declarations the executed opcodes map to.
+type Recorder struct {
+	source    source.ContractSource
+	contracts map[common.Hash]*contractInfo
+
+	baseDepth int
+	frames    []*frame
+	steps     int
+	stops     []*Stop
+
+	memory []byte
+	// storage holds the slots accessed so far per account. Their values are
+	// read back from the state at every stop, which rolls back the writes of
+	// reverted calls.
+	storage        map[common.Address]map[common.Hash]bool
+	stateVariables map[stateKey][]*tracers.StorageVariable
+	accessed       map[common.Address]bool
+	preimages      *tracers.Preimages
+
+	output []byte
+	err    error
+}
+
+// NewRecorder creates a recorder resolving contracts through the given source.
+func NewRecorder(contracts source.ContractSource) *Recorder {
+	return &Recorder{
+		source:         contracts,
+		contracts:      make(map[common.Hash]*contractInfo),
+		storage:        make(map[common.Address]map[common.Hash]bool),
+		stateVariables: make(map[stateKey][]*tracers.StorageVariable),
+		accessed:       make(map[common.Address]bool),
+		preimages:      tracers.NewPreimages(),
+	}
+}
+
+func (r *Recorder) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	return nil
+}
+
+func (r *Recorder) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if r.frames == nil {
+		r.baseDepth = depth
+	}
+
+	for len(r.frames) > depth-r.baseDepth+1 {
+		// The storage of a call which reverted is rolled back, so its state
+		// variables are decoded again.
+		r.accessed[r.frames[len(r.frames)-1].address] = true
+		r.frames = r.frames[:len(r.frames)-1]
+	}
+	if len(r.frames) < depth-r.baseDepth+1 {
+		info := r.contractInfo(contract)
+		name := info.name
+		if name == "" {
+			name = contract.Address().String()
+		}
+
+		r.frames = append(r.frames, &frame{
+			contract:  name,
+			address:   contract.Address(),
+			sourceMap: info.sourceMap,
+			functions: []*function{{name: UnknownFunction}},
+		})
+	}
+
+	current := r.frames[len(r.frames)-1]
+	r.enter(current, contract, pc, op, stack)
+
+	mapping := current.sourceMap.At(int(pc))
+	if mapping != nil && !mapping.Generated && mapping.Line > 0 {
+		top := current.functions[len(current.functions)-1]
+		if top.location == nil || top.location.File != mapping.File || top.location.Line != mapping.Line {
+			top.location = &Location{
+				File:   mapping.File,
+				Line:   mapping.Line,
+				Column: mapping.Column,
+			}
+			r.stop(env, pc, op, gas, memory, stack, contract, depth)
+		}
+	}
+
+	r.access(op, stack, contract)
+	r.preimages.Record(op, memory, stack, contract)
+	r.leave(current, pc, op)
+	r.steps++
+
+	if err != nil {
+		r.fail(err)
+	}
+
+	return nil
+}
+
+func (r *Recorder) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	r.fail(err)
+	return nil
+}
+
+func (r *Recorder) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) error {
+	r.output = output
+	r.err = err
+	r.frames = nil
+
+	return nil
+}
+
+// Debugger returns a debugger over the recorded execution.
+func (r *Recorder) Debugger() *Debugger {
+	return New(r.stops, r.output, r.err)
+}
+
+func (r *Recorder) contractInfo(contract *vm.Contract) *contractInfo {
+	if info, ok := r.contracts[contract.CodeHash]; ok {
+		return info
+	}
+
+	// Delegate calls run the code of another address than their own.
+	address := contract.Address()
+	if contract.CodeAddr != nil {
+		address = *contract.CodeAddr
+	}
+
+	code := "0x" + hex.EncodeToString(contract.Code)
+	info := &contractInfo{
+		name:      r.source.GetName(address.Hex(), code),
+		sourceMap: r.source.GetSourceMap(address.Hex(), code),
+	}
+
+	r.contracts[contract.CodeHash] = info
+	return info
+}
+
+// enter updates the internal function calls of the frame before the opcode is
+// executed, placing the variables declared by the previous opcode and
+// collecting the ones declared by this one.
+func (r *Recorder) enter(f *frame, contract *vm.Contract, pc uint64, op vm.OpCode, stack *vm.Stack) {
+	top := f.functions[len(f.functions)-1]
+	height := len(stack.Data())
+
+	for _, node := range top.pending {
+		top.declare(node, height-1)
+	}
+	top.pending = nil
+
+	node := contract.Ast[uint(pc)]
+	if node == nil {
+		f.entering = false
+		return
+	}
+
+	switch {
+	case op == vm.JUMPDEST && node.NodeType == "FunctionDefinition":
+		if f.entering || top.name == UnknownFunction {
+			top.name = node.Name
+
+			// Parameters are on top of the stack when the function is
+			// entered, the last one topmost.
+			position := 0
+			parameters := node.Parameters.Parameters
+			for i := len(parameters) - 1; i >= 0; i-- {
+				top.declare(&parameters[i], height-1-position)
+				position += tracers.StackSize(parameters[i].TypeName.TypeDescription.TypeIdentifier)
+			}
+		}
+	case node.NodeType == "VariableDeclaration" && !node.StateVariable:
+		if top.variable(node.Id, height) == nil {
+			top.pending = append(top.pending, node)
+		}
+	}
+	f.entering = false
+}
+
+// leave updates the internal function calls of the frame after the opcode is
+// executed.
+func (r *Recorder) leave(f *frame, pc uint64, op vm.OpCode) {
+	if op != vm.JUMP {
+		return
+	}
+
+	mapping := f.sourceMap.At(int(pc))
+	if mapping == nil {
+		return
+	}
+
+	switch mapping.Jump {
+	case source.JumpInto:
+		f.functions = append(f.functions, &function{name: UnknownFunction})
+		f.entering = true
+	case source.JumpOutOf:
+		if len(f.functions) > 1 {
+			f.functions = f.functions[:len(f.functions)-1]
+		}
+	}
+}
+
+// access keeps track of the storage slots read and written by the opcode.
+func (r *Recorder) access(op vm.OpCode, stack *vm.Stack, contract *vm.Contract) {
+	if op != vm.SLOAD && op != vm.SSTORE {
+		return
+	}
+
+	// Opcodes failing on a stack underflow are captured too.
+	data := stack.Data()
+	if len(data) < 1 || op == vm.SSTORE && len(data) < 2 {
+		return
+	}
+
+	address := contract.Address()
+	if r.storage[address] == nil {
+		r.storage[address] = make(map[common.Hash]bool)
+	}
+
+	r.storage[address][common.BigToHash(data[len(data)-1])] = true
+	r.accessed[address] = true
+}
+
+// stop records the state of the execution at the opcode about to be executed.
+func (r *Recorder) stop(env *vm.EVM, pc uint64, op vm.OpCode, gas uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int) {
+	current := r.frames[len(r.frames)-1]
+	top := current.functions[len(current.functions)-1]
+
+	s := &Stop{
+		Index:    len(r.stops),
+		Step:     r.steps,
+		Pc:       pc,
+		Op:       op,
+		Gas:      gas,
+		Depth:    depth,
+		Location: *top.location,
+	}
+
+	for _, f := range r.frames {
+		for _, fn := range f.functions {
+			var location *Location
+			if fn.location != nil {
+				copied := *fn.location
+				location = &copied
+			}
+
+			s.CallStack = append(s.CallStack, &Frame{
+				Contract: f.contract,
+				Address:  f.address,
+				Function: fn.name,
+				Location: location,
+			})
+		}
+	}
+
+	data := stack.Data()
+	for _, word := range data {
+		s.Stack = append(s.Stack, new(big.Int).Set(word))
+	}
+
+	// Memory only changes on some opcodes, the stops in between share it.
+	if !bytes.Equal(r.memory, memory.Data()) {
+		r.memory = append([]byte{}, memory.Data()...)
+	}
+	s.Memory = r.memory
+
+	s.Storage = make(map[common.Hash]common.Hash)
+	for slot := range r.storage[contract.Address()] {
+		s.Storage[slot] = env.StateDB.GetState(contract.Address(), slot)
+	}
+
+	for _, variable := range top.locals {
+		if variable.index >= len(data) {
+			continue
+		}
+
+		s.Locals = append(s.Locals, &Variable{
+			Name:  variable.name,
+			Type:  variable.typ,
+			Value: tracers.DecodeVariable(variable.identifier, len(data)-1-variable.index, stack, memory, contract, env.StateDB, r.preimages),
+		})
+	}
+
+	// State variables are decoded again only after the storage was accessed.
+	key := stateKey{contract.Address(), contract.CodeHash}
+	variables, ok := r.stateVariables[key]
+	if !ok || r.accessed[key.address] {
+		variables = tracers.DecodeStateVariables(env.StateDB, contract, r.preimages)
+		r.stateVariables[key] = variables
+		r.accessed[key.address] = false
+	}
+	s.StateVariables = variables
+
+	r.stops = append(r.stops, s)
+}
+
+// fail marks the line being executed as failed.
+func (r *Recorder) fail(err error) {
+	if len(r.stops) == 0 {
+		return
+	}
+
+	s := r.stops[len(r.stops)-1]
+	if s.Error == "" {
+		s.Error = err.Error()
+	}
+}
+
+// declare adds the variable whose topmost stack slot is at the given index.
+// Variables declared again, like the ones of loop bodies, replace the
+// previous declaration.
+func (fn *function) declare(node *types.Node, index int) {
+	if index < 0 {
+		return
+	}
+
+	variable := &local{
+		id:         node.Id,
+		name:       node.Name,
+		typ:        node.TypeName.TypeDescription.TypeString,
+		identifier: node.TypeName.TypeDescription.TypeIdentifier,
+		index:      index,
+	}
+
+	for i, existing := range fn.locals {
+		if existing.id == node.Id {
+			fn.locals[i] = variable
+			return
+		}
+	}
+
+	fn.locals = append(fn.locals, variable)
+}
+
+// variable returns the declared variable with the given id, if it is still in
+// scope with a stack of the given height.
+func (fn *function) variable(id int, height int) *local {
+	for _, variable := range fn.locals {
+		if variable.id == id && variable.index < height {
+			return variable
+		}
+	}
+
+	return nil
+}
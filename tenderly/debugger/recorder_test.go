@@ -0,0 +1,231 @@
+package debugger
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/tenderly/tenderly-trace/ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
+	"github.com/tenderly/tenderly-trace/ethereum/signer/accounts/abi"
+	"github.com/tenderly/tenderly-trace/source"
+)
+
+var (
+	testCaller  = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	testAddress = common.HexToAddress("0x00000000000000000000000000000000000000bb")
+)
+
+// testState is the state of a single account holding the code being run.
+// Methods the execution doesn't reach are left unimplemented.
+type testState struct {
+	vm.StateDB
+	code      []byte
+	storage   map[common.Hash]common.Hash
+	snapshots []map[common.Hash]common.Hash
+	refund    uint64
+}
+
+func (s *testState) Exist(address common.Address) bool { return address == testAddress }
+func (s *testState) GetCode(address common.Address) []byte {
+	if address != testAddress {
+		return nil
+	}
+	return s.code
+}
+func (s *testState) GetCodeHash(address common.Address) common.Hash {
+	return common.BytesToHash(s.GetCode(address))
+}
+func (s *testState) GetCodeAst(common.Address) types.Ast                  { return nil }
+func (s *testState) GetStateVariables(common.Address) []*types.Node       { return nil }
+func (s *testState) GetDefinitions(common.Address) types.Definitions      { return nil }
+func (s *testState) GetStorageLayout(common.Address) *types.StorageLayout { return nil }
+func (s *testState) GetAbi(common.Address) *abi.ABI                       { return nil }
+func (s *testState) GetState(address common.Address, slot common.Hash) common.Hash {
+	return s.storage[slot]
+}
+func (s *testState) SetState(address common.Address, slot common.Hash, value common.Hash) {
+	s.storage[slot] = value
+}
+func (s *testState) AddRefund(gas uint64) { s.refund += gas }
+func (s *testState) GetRefund() uint64    { return s.refund }
+func (s *testState) Snapshot() int {
+	copied := make(map[common.Hash]common.Hash)
+	for slot, value := range s.storage {
+		copied[slot] = value
+	}
+	s.snapshots = append(s.snapshots, copied)
+	return len(s.snapshots) - 1
+}
+func (s *testState) RevertToSnapshot(id int) {
+	s.storage = s.snapshots[id]
+	s.snapshots = s.snapshots[:id]
+}
+
+// testContract maps its code to the lines of Test.sol, one line per entry of
+// lines, which holds the offset of the first instruction of the line.
+type testContract struct {
+	code  []byte
+	lines []int
+}
+
+func (c *testContract) GetContractName() string                            { return "Test" }
+func (c *testContract) GetContractAst(source.SourceMap) types.Ast          { return nil }
+func (c *testContract) GetContractStateVariables() []*types.Node           { return nil }
+func (c *testContract) GetContractDefinitions() types.Definitions          { return nil }
+func (c *testContract) GetContractStorageLayout() *types.StorageLayout     { return nil }
+func (c *testContract) GetContractAbi() *abi.ABI                           { return nil }
+func (c *testContract) GetContractDeployedBytecode() string                { return "0x" + hex.EncodeToString(c.code) }
+func (c *testContract) GetContractImmutableReferences() []source.CodeRange { return nil }
+
+func (c *testContract) GetContractSourceMap() (source.SourceMap, error) {
+	sourceMap := make(source.SourceMap, len(c.code))
+	var mapping *source.InstructionMapping
+	for pc := range c.code {
+		for line, start := range c.lines {
+			if start == pc {
+				mapping = &source.InstructionMapping{Index: pc, File: "Test.sol", Line: line + 1, Column: 1}
+			}
+		}
+		sourceMap[pc] = mapping
+	}
+
+	return sourceMap, nil
+}
+
+// record runs the code with the recorder and returns the debugger over the
+// execution.
+func record(t *testing.T, code []byte, lines ...int) *Debugger {
+	t.Helper()
+
+	contract := &testContract{code: code, lines: lines}
+	contracts := source.NewContractSource(map[string]source.Contract{
+		contract.GetContractDeployedBytecode(): contract,
+	}, nil)
+	recorder := NewRecorder(contracts)
+
+	ctx := vm.Context{
+		CanTransfer: func(vm.StateDB, common.Address, *big.Int) bool { return true },
+		Transfer:    func(vm.StateDB, common.Address, common.Address, *big.Int) {},
+		BlockNumber: big.NewInt(1),
+		Time:        big.NewInt(0),
+		Difficulty:  big.NewInt(0),
+		GasPrice:    big.NewInt(0),
+	}
+	state := &testState{code: code, storage: make(map[common.Hash]common.Hash)}
+	evm := vm.NewEVM(ctx, state, params.AllEthashProtocolChanges, vm.Config{Debug: true, Tracer: recorder})
+
+	evm.Call(vm.AccountRef(testCaller), testAddress, nil, 100000, big.NewInt(0))
+
+	return recorder.Debugger()
+}
+
+func TestRecord(t *testing.T) {
+	code := []byte{
+		// line 1: PUSH1 0x2a PUSH1 0x00 SSTORE
+		byte(vm.PUSH1), 0x2a, byte(vm.PUSH1), 0x00, byte(vm.SSTORE),
+		// line 2: PUSH1 0x00 SLOAD POP
+		byte(vm.PUSH1), 0x00, byte(vm.SLOAD), byte(vm.POP),
+		// line 3: STOP
+		byte(vm.STOP),
+	}
+
+	d := record(t, code, 0, 5, 9)
+	if d.Err() != nil {
+		t.Fatalf("unexpected error: %s", d.Err())
+	}
+
+	stops := d.Stops()
+	if len(stops) != 3 {
+		t.Fatalf("expected 3 stops, got %d", len(stops))
+	}
+	for i, s := range stops {
+		if s.Location.File != "Test.sol" || s.Location.Line != i+1 {
+			t.Errorf("stop %d: expected Test.sol:%d, got %+v", i, i+1, s.Location)
+		}
+		if s.Error != "" {
+			t.Errorf("stop %d: unexpected error %s", i, s.Error)
+		}
+	}
+
+	if s := stops[1]; s.Step != 3 || s.Pc != 5 || len(s.Stack) != 0 {
+		t.Errorf("expected the second line at step 3 with an empty stack, got %+v", s)
+	}
+	if value := stops[2].Storage[common.Hash{}]; value != common.BigToHash(big.NewInt(0x2a)) {
+		t.Errorf("expected the stored value in storage, got %s", value.Hex())
+	}
+	if frames := stops[2].CallStack; len(frames) != 1 || frames[0].Contract != "Test" || frames[0].Address != testAddress {
+		t.Errorf("unexpected call stack %+v", frames)
+	}
+}
+
+// Storage accesses failing on a stack underflow are recorded as failures
+// of their line.
+func TestRecordFailure(t *testing.T) {
+	code := []byte{
+		// line 1: PUSH1 0x2a PUSH1 0x00 SSTORE
+		byte(vm.PUSH1), 0x2a, byte(vm.PUSH1), 0x00, byte(vm.SSTORE),
+		// line 2: SLOAD
+		byte(vm.SLOAD),
+	}
+
+	d := record(t, code, 0, 5)
+	if d.Err() == nil {
+		t.Fatalf("expected the execution to fail")
+	}
+
+	stops := d.Stops()
+	if len(stops) != 2 {
+		t.Fatalf("expected 2 stops, got %d", len(stops))
+	}
+	if stops[0].Error != "" {
+		t.Errorf("unexpected error on the first line: %s", stops[0].Error)
+	}
+	if stops[1].Location.Line != 2 || stops[1].Error == "" {
+		t.Errorf("expected the second line failed, got %+v", stops[1])
+	}
+}
+
+// Storage written by a call which reverted is shown with its value from
+// before the call once the caller resumes.
+func TestRecordRevertedStorage(t *testing.T) {
+	code := []byte{
+		// line 1: CALLDATASIZE PUSH1 0x2b JUMPI, the call with calldata jumps to line 4
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0x2b, byte(vm.JUMPI),
+		// line 2: call itself with a byte of calldata
+		byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x01, byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00,
+		byte(vm.PUSH20),
+	}
+	code = append(code, testAddress.Bytes()...)
+	code = append(code,
+		byte(vm.GAS), byte(vm.CALL), byte(vm.POP),
+		// line 3: PUSH1 0x00 SLOAD POP STOP
+		byte(vm.PUSH1), 0x00, byte(vm.SLOAD), byte(vm.POP), byte(vm.STOP),
+		// line 4: JUMPDEST PUSH1 0x2a PUSH1 0x00 SSTORE
+		byte(vm.JUMPDEST), byte(vm.PUSH1), 0x2a, byte(vm.PUSH1), 0x00, byte(vm.SSTORE),
+		// line 5: PUSH1 0x00 PUSH1 0x00 REVERT
+		byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, byte(vm.REVERT),
+	)
+
+	d := record(t, code, 0, 4, 38, 43, 49)
+	if d.Err() != nil {
+		t.Fatalf("unexpected error: %s", d.Err())
+	}
+
+	lines := make(map[int]*Stop)
+	for _, s := range d.Stops() {
+		lines[s.Location.Line] = s
+	}
+	if lines[5] == nil || lines[3] == nil {
+		t.Fatalf("expected stops on the revert and after the call, got %d stops", len(d.Stops()))
+	}
+
+	if value := lines[5].Storage[common.Hash{}]; value != common.BigToHash(big.NewInt(0x2a)) {
+		t.Errorf("expected the stored value before the revert, got %s", value.Hex())
+	}
+	if value, ok := lines[3].Storage[common.Hash{}]; !ok || value != (common.Hash{}) {
+		t.Errorf("expected the stored value rolled back after the revert, got %s", value.Hex())
+	}
+}
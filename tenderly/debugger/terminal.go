@@ -0,0 +1,329 @@
+package debugger
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const terminalHelp = `Commands:
+  s, step              step into the next line
+  n, next              step over to the next line of the function
+  o, out               step out to the caller
+  b, back              step back to the previous line of the function
+  c, continue          run to the next breakpoint
+  r, reverse           run back to the previous breakpoint
+  break <file>:<line>  set a breakpoint
+  delete <id>          remove a breakpoint
+  breakpoints          list breakpoints
+  bt, where            print the call stack
+  l, list              print the source around the current line
+  locals               print the local variables
+  state                print the state variables
+  stack                print the stack
+  memory               print the memory
+  storage              print the storage slots accessed so far
+  h, help              print this help
+  q, quit              leave the debugger
+`
+
+// listContext is the number of lines listed around the current line.
+const listContext = 4
+
+// Terminal is a line based front-end of a debugger. Sources are read from the
+// project root, since source maps refer to them by their project path.
+type Terminal struct {
+	debugger *Debugger
+	root     string
+	sources  map[string][]string
+
+	in  io.Reader
+	out io.Writer
+}
+
+// NewTerminal creates a terminal reading commands from in and writing to out.
+func NewTerminal(debugger *Debugger, root string, in io.Reader, out io.Writer) *Terminal {
+	return &Terminal{
+		debugger: debugger,
+		root:     root,
+		sources:  make(map[string][]string),
+		in:       in,
+		out:      out,
+	}
+}
+
+// Run executes commands until the input ends or the debugger is left.
+func (t *Terminal) Run() error {
+	if t.debugger.Current() == nil {
+		fmt.Fprintln(t.out, "The transaction executed no code with known sources.")
+		return nil
+	}
+
+	t.printLocation()
+
+	scanner := bufio.NewScanner(t.in)
+	for {
+		fmt.Fprint(t.out, "(debug) ")
+		if !scanner.Scan() {
+			break
+		}
+
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		if !t.execute(fields[0], fields[1:]) {
+			return nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed reading debugger command: %s", err)
+	}
+
+	return nil
+}
+
+// execute runs a command and reports whether the debugger should go on.
+func (t *Terminal) execute(command string, args []string) bool {
+	d := t.debugger
+
+	switch command {
+	case "s", "step":
+		t.move(d.StepInto(), true)
+	case "n", "next":
+		t.move(d.StepOver(), true)
+	case "o", "out":
+		t.move(d.StepOut(), true)
+	case "b", "back":
+		t.move(d.StepBack(), false)
+	case "c", "continue":
+		t.reportBreakpoint(d.Continue())
+		t.printLocation()
+	case "r", "reverse":
+		t.reportBreakpoint(d.ReverseContinue())
+		t.printLocation()
+	case "break":
+		t.setBreakpoint(args)
+	case "delete":
+		t.clearBreakpoint(args)
+	case "breakpoints":
+		for _, breakpoint := range d.Breakpoints() {
+			fmt.Fprintf(t.out, "%d  %s:%d\n", breakpoint.Id, breakpoint.File, breakpoint.Line)
+		}
+	case "bt", "where":
+		t.printCallStack()
+	case "l", "list":
+		t.printSource()
+	case "locals":
+		for _, variable := range d.Current().Locals {
+			fmt.Fprintf(t.out, "%s %s = %s\n", variable.Type, variable.Name, formatValue(variable.Value))
+		}
+	case "state":
+		for _, variable := range d.Current().StateVariables {
+			fmt.Fprintf(t.out, "%s %s = %s\n", variable.Type, variable.Name, formatValue(variable.Value))
+		}
+	case "stack":
+		stack := d.Current().Stack
+		for i := len(stack) - 1; i >= 0; i-- {
+			fmt.Fprintf(t.out, "%4d  %s\n", len(stack)-1-i, hexutil.EncodeBig(stack[i]))
+		}
+	case "memory":
+		memory := d.Current().Memory
+		for offset := 0; offset < len(memory); offset += 32 {
+			end := offset + 32
+			if end > len(memory) {
+				end = len(memory)
+			}
+			fmt.Fprintf(t.out, "%#06x  %s\n", offset, hex.EncodeToString(memory[offset:end]))
+		}
+	case "storage":
+		storage := d.Current().Storage
+		var slots []common.Hash
+		for slot := range storage {
+			slots = append(slots, slot)
+		}
+		sort.Slice(slots, func(i, j int) bool {
+			return slots[i].Big().Cmp(slots[j].Big()) < 0
+		})
+		for _, slot := range slots {
+			fmt.Fprintf(t.out, "%s  %s\n", slot.Hex(), storage[slot].Hex())
+		}
+	case "h", "help":
+		fmt.Fprint(t.out, terminalHelp)
+	case "q", "quit":
+		return false
+	default:
+		fmt.Fprintf(t.out, "Unknown command %s, type help for the list of commands.\n", command)
+	}
+
+	return true
+}
+
+// move reports the outcome of a step in the given direction. Steps which
+// find nowhere to stop leave the debugger in place.
+func (t *Terminal) move(moved bool, forward bool) {
+	switch {
+	case moved:
+		t.printLocation()
+	case forward:
+		fmt.Fprintln(t.out, "No more lines to step to before the end of the transaction.")
+		t.printResult()
+	default:
+		fmt.Fprintln(t.out, "No earlier lines to step back to.")
+	}
+}
+
+func (t *Terminal) reportBreakpoint(breakpoint *Breakpoint) {
+	if breakpoint != nil {
+		fmt.Fprintf(t.out, "Breakpoint %d at %s:%d\n", breakpoint.Id, breakpoint.File, breakpoint.Line)
+		return
+	}
+	if t.debugger.AtEnd() {
+		fmt.Fprintln(t.out, "End of the transaction.")
+		t.printResult()
+	}
+}
+
+func (t *Terminal) setBreakpoint(args []string) {
+	if len(args) != 1 {
+		fmt.Fprintln(t.out, "Usage: break <file>:<line>")
+		return
+	}
+
+	separator := strings.LastIndex(args[0], ":")
+	if separator < 0 {
+		fmt.Fprintln(t.out, "Usage: break <file>:<line>")
+		return
+	}
+
+	line, err := strconv.Atoi(args[0][separator+1:])
+	if err != nil || line <= 0 {
+		fmt.Fprintf(t.out, "Invalid line %s\n", args[0][separator+1:])
+		return
+	}
+
+	breakpoint := t.debugger.SetBreakpoint(args[0][:separator], line)
+	fmt.Fprintf(t.out, "Breakpoint %d at %s:%d", breakpoint.Id, breakpoint.File, breakpoint.Line)
+	if !t.debugger.Verified(breakpoint) {
+		fmt.Fprint(t.out, " (never reached by the transaction)")
+	}
+	fmt.Fprintln(t.out)
+}
+
+func (t *Terminal) clearBreakpoint(args []string) {
+	if len(args) != 1 {
+		fmt.Fprintln(t.out, "Usage: delete <id>")
+		return
+	}
+
+	id, err := strconv.Atoi(args[0])
+	if err != nil || !t.debugger.ClearBreakpoint(id) {
+		fmt.Fprintf(t.out, "No breakpoint %s\n", args[0])
+	}
+}
+
+func (t *Terminal) printResult() {
+	if err := t.debugger.Err(); err != nil {
+		fmt.Fprintf(t.out, "Execution failed: %s\n", err)
+		return
+	}
+
+	fmt.Fprintf(t.out, "Returned %s\n", hexutil.Encode(t.debugger.Output()))
+}
+
+func (t *Terminal) printLocation() {
+	current := t.debugger.Current()
+	frame := current.CallStack[len(current.CallStack)-1]
+
+	fmt.Fprintf(t.out, "%s.%s at %s:%d\n", frame.Contract, frame.Function, current.Location.File, current.Location.Line)
+	if line, ok := t.line(current.Location.File, current.Location.Line); ok {
+		fmt.Fprintf(t.out, "%5d  %s\n", current.Location.Line, line)
+	}
+	if current.Error != "" {
+		fmt.Fprintf(t.out, "Fails with: %s\n", current.Error)
+	}
+}
+
+func (t *Terminal) printCallStack() {
+	stack := t.debugger.Current().CallStack
+	for i := len(stack) - 1; i >= 0; i-- {
+		frame := stack[i]
+		location := ""
+		if frame.Location != nil {
+			location = fmt.Sprintf(" at %s:%d", frame.Location.File, frame.Location.Line)
+		}
+
+		fmt.Fprintf(t.out, "#%d  %s.%s%s\n", len(stack)-1-i, frame.Contract, frame.Function, location)
+	}
+}
+
+func (t *Terminal) printSource() {
+	location := t.debugger.Current().Location
+	lines := t.source(location.File)
+	if lines == nil {
+		fmt.Fprintf(t.out, "Source %s not found\n", location.File)
+		return
+	}
+
+	for i := location.Line - listContext; i <= location.Line+listContext; i++ {
+		if i < 1 || i > len(lines) {
+			continue
+		}
+
+		marker := " "
+		if i == location.Line {
+			marker = ">"
+		}
+		fmt.Fprintf(t.out, "%s%5d  %s\n", marker, i, lines[i-1])
+	}
+}
+
+func (t *Terminal) line(file string, line int) (string, bool) {
+	lines := t.source(file)
+	if line < 1 || line > len(lines) {
+		return "", false
+	}
+
+	return strings.TrimSpace(lines[line-1]), true
+}
+
+// source returns the lines of the source file, or nil if it can't be read.
+func (t *Terminal) source(file string) []string {
+	if lines, ok := t.sources[file]; ok {
+		return lines
+	}
+
+	path := file
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(t.root, path)
+	}
+
+	var lines []string
+	if data, err := ioutil.ReadFile(path); err == nil {
+		lines = strings.Split(string(data), "\n")
+	}
+
+	t.sources[file] = lines
+	return lines
+}
+
+func formatValue(value interface{}) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(encoded)
+}
@@ -0,0 +1,31 @@
+package tenderly
+
+import (
+	"github.com/tenderly/tenderly-trace/source"
+	"github.com/tenderly/tenderly-trace/tenderly/profiler"
+)
+
+// Profile re-executes the transaction and attributes the gas it spent to the
+// contracts, functions and source lines of the given source.
+func (t Tenderly) Profile(txHash string, cs source.Source) (*profiler.Profile, error) {
+	p := profiler.New(cs.GetSource())
+
+	_, err := t.replay(txHash, cs, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Profile(), nil
+}
+
+// ExecutionTree re-executes the transaction with the default tracer and
+// returns its call tree, internal function calls included, ready to be
+// exported by the profiler package.
+func (t Tenderly) ExecutionTree(txHash string, cs source.Source) (*profiler.Frame, error) {
+	result, err := t.Trace(txHash, cs, &TraceOptions{Tracer: DefaultTracer})
+	if err != nil {
+		return nil, err
+	}
+
+	return profiler.FromCallTrace(result)
+}
@@ -0,0 +1,45 @@
+package profiler
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteFolded writes the tree as folded stacks, one line per frame with its
+// semicolon separated stack followed by its exclusive value, the input format
+// of flamegraph.pl.
+func WriteFolded(w io.Writer, root *Frame, sample Sample) error {
+	bw := bufio.NewWriter(w)
+
+	var err error
+	root.Walk(func(stack []*Frame) {
+		value := stack[len(stack)-1].Value(sample)
+		if err != nil || value == 0 {
+			return
+		}
+
+		names := make([]string, len(stack))
+		for i, frame := range stack {
+			names[i] = foldedName(frame.Name)
+		}
+
+		_, err = bw.WriteString(strings.Join(names, ";") + " " + strconv.FormatUint(value, 10) + "\n")
+	})
+	if err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+// foldedName strips the separators of the folded format from the frame name.
+func foldedName(name string) string {
+	name = strings.NewReplacer(";", ":", " ", "_", "\n", "_").Replace(name)
+	if name == "" {
+		return UnknownFunction
+	}
+
+	return name
+}
@@ -0,0 +1,64 @@
+package profiler
+
+import (
+	"io"
+
+	"github.com/google/pprof/profile"
+)
+
+// WritePprof writes the tree as a gzipped pprof protobuf profile holding both
+// the gas and the opcode samples, readable by go tool pprof.
+func WritePprof(w io.Writer, root *Frame) error {
+	p := &profile.Profile{
+		DefaultSampleType: GasSample.String(),
+	}
+	for _, sample := range []Sample{GasSample, OpcodeSample} {
+		p.SampleType = append(p.SampleType, &profile.ValueType{
+			Type: sample.String(),
+			Unit: "count",
+		})
+	}
+
+	// Every frame name gets a function and a location with the same id.
+	locations := make(map[string]*profile.Location)
+	location := func(name string) *profile.Location {
+		if location, ok := locations[name]; ok {
+			return location
+		}
+
+		id := uint64(len(p.Location) + 1)
+		function := &profile.Function{
+			ID:         id,
+			Name:       name,
+			SystemName: name,
+		}
+		p.Function = append(p.Function, function)
+
+		location := &profile.Location{
+			ID:   id,
+			Line: []profile.Line{{Function: function}},
+		}
+		p.Location = append(p.Location, location)
+
+		locations[name] = location
+		return location
+	}
+
+	root.Walk(func(stack []*Frame) {
+		frame := stack[len(stack)-1]
+		if frame.Gas == 0 && frame.Opcodes == 0 {
+			return
+		}
+
+		// pprof stacks start with the innermost frame.
+		sample := &profile.Sample{
+			Value: []int64{int64(frame.Gas), int64(frame.Opcodes)},
+		}
+		for i := len(stack) - 1; i >= 0; i-- {
+			sample.Location = append(sample.Location, location(foldedName(stack[i].Name)))
+		}
+		p.Sample = append(p.Sample, sample)
+	})
+
+	return p.Write(w)
+}
@@ -0,0 +1,446 @@
+// Package profiler attributes the gas spent by a transaction to the contracts,
+// functions and source lines that spent it.
+package profiler
+
+import (
+	"encoding/hex"
+	"math/big"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
+	"github.com/tenderly/tenderly-trace/source"
+)
+
+// UnknownFunction names the code which couldn't be attributed to a function,
+// either because the contract source is missing or because it is generated by
+// the compiler, like the function dispatcher.
+const UnknownFunction = "<unknown>"
+
+// Gas is the gas attributed to a profile entry. Exclusive gas was spent by the
+// entry itself, inclusive gas also counts the gas spent by everything it called.
+type Gas struct {
+	Inclusive uint64 `json:"inclusive"`
+	Exclusive uint64 `json:"exclusive"`
+}
+
+type ContractProfile struct {
+	Contract string `json:"contract"`
+	Gas
+}
+
+type FunctionProfile struct {
+	Contract string `json:"contract"`
+	Function string `json:"function"`
+	Gas
+}
+
+type LineProfile struct {
+	Contract string `json:"contract"`
+	File     string `json:"file,omitempty"`
+	Line     int    `json:"line"`
+	Gas
+}
+
+// Profile is the gas attribution of a single transaction. Every list is
+// sorted by exclusive gas, the most expensive entries first.
+type Profile struct {
+	Total     uint64             `json:"total"`
+	Contracts []*ContractProfile `json:"contracts"`
+	Functions []*FunctionProfile `json:"functions"`
+	Lines     []*LineProfile     `json:"lines"`
+}
+
+type functionKey struct {
+	contract string
+	function string
+}
+
+type lineKey struct {
+	contract string
+	file     string
+	line     int
+}
+
+// function is an internal function call in progress.
+type function struct {
+	name string
+	// file and line are the last source line executed in the function,
+	// which is the call site while the function is calling another one.
+	file string
+	line int
+}
+
+// step holds the attribution of an executed opcode until its gas is known.
+type step struct {
+	gas     uint64
+	cost    uint64
+	charged uint64
+
+	contracts []string
+	functions []functionKey
+	lines     []lineKey
+}
+
+// frame is an external call in progress.
+type frame struct {
+	contract  string
+	sourceMap source.SourceMap
+	functions []*function
+	// entering is set by a jump into a function, the following JUMPDEST names it.
+	entering bool
+	pending  *step
+}
+
+type contractInfo struct {
+	name      string
+	sourceMap source.SourceMap
+}
+
+// Profiler is a vm.Tracer which aggregates the gas spent by every executed
+// opcode per contract, function and source line.
+//
+// The gas of an opcode is measured as the difference of the remaining gas
+// before it and before the next opcode of the same call, minus the gas spent
+// by the calls it made. Internal functions are tracked through the jump
+// markers of the source map.
+type Profiler struct {
+	source    source.ContractSource
+	contracts map[common.Hash]*contractInfo
+
+	baseDepth int
+	frames    []*frame
+	charged   uint64
+
+	contractGas map[string]*Gas
+	functionGas map[functionKey]*Gas
+	lineGas     map[lineKey]*Gas
+}
+
+// New creates a profiler resolving contracts through the given source.
+func New(contracts source.ContractSource) *Profiler {
+	return &Profiler{
+		source:      contracts,
+		contracts:   make(map[common.Hash]*contractInfo),
+		contractGas: make(map[string]*Gas),
+		functionGas: make(map[functionKey]*Gas),
+		lineGas:     make(map[lineKey]*Gas),
+	}
+}
+
+func (p *Profiler) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	return nil
+}
+
+func (p *Profiler) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if p.frames == nil {
+		p.baseDepth = depth
+	}
+
+	// Calls which returned since the previous step are done, their last step
+	// is charged with its own cost.
+	for len(p.frames) > depth-p.baseDepth+1 {
+		p.popFrame()
+	}
+	if len(p.frames) < depth-p.baseDepth+1 {
+		info := p.contractInfo(contract)
+		name := info.name
+		if name == "" {
+			name = contract.Address().String()
+		}
+
+		p.frames = append(p.frames, &frame{
+			contract:  name,
+			sourceMap: info.sourceMap,
+			functions: []*function{{name: UnknownFunction}},
+		})
+	}
+
+	current := p.frames[len(p.frames)-1]
+	if current.pending != nil {
+		spent := current.pending.gas - gas
+		called := p.charged - current.pending.charged
+		if spent > called {
+			p.charge(current.pending, spent-called)
+		} else {
+			p.charge(current.pending, 0)
+		}
+		current.pending = nil
+	}
+
+	file, line := p.enter(current, contract, pc, op)
+	current.pending = p.attribute(gas, cost, file, line)
+	p.leave(current, pc, op)
+
+	return nil
+}
+
+func (p *Profiler) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	return nil
+}
+
+func (p *Profiler) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) error {
+	for len(p.frames) > 0 {
+		p.popFrame()
+	}
+
+	return nil
+}
+
+// Profile returns the gas attributed so far.
+func (p *Profiler) Profile() *Profile {
+	profile := &Profile{
+		Total: p.charged,
+	}
+
+	for contract, gas := range p.contractGas {
+		profile.Contracts = append(profile.Contracts, &ContractProfile{
+			Contract: contract,
+			Gas:      *gas,
+		})
+	}
+	sort.Slice(profile.Contracts, func(i, j int) bool {
+		a, b := profile.Contracts[i], profile.Contracts[j]
+		if a.Exclusive != b.Exclusive {
+			return a.Exclusive > b.Exclusive
+		}
+		return a.Contract < b.Contract
+	})
+
+	for key, gas := range p.functionGas {
+		profile.Functions = append(profile.Functions, &FunctionProfile{
+			Contract: key.contract,
+			Function: key.function,
+			Gas:      *gas,
+		})
+	}
+	sort.Slice(profile.Functions, func(i, j int) bool {
+		a, b := profile.Functions[i], profile.Functions[j]
+		if a.Exclusive != b.Exclusive {
+			return a.Exclusive > b.Exclusive
+		}
+		if a.Contract != b.Contract {
+			return a.Contract < b.Contract
+		}
+		return a.Function < b.Function
+	})
+
+	for key, gas := range p.lineGas {
+		profile.Lines = append(profile.Lines, &LineProfile{
+			Contract: key.contract,
+			File:     key.file,
+			Line:     key.line,
+			Gas:      *gas,
+		})
+	}
+	sort.Slice(profile.Lines, func(i, j int) bool {
+		a, b := profile.Lines[i], profile.Lines[j]
+		if a.Exclusive != b.Exclusive {
+			return a.Exclusive > b.Exclusive
+		}
+		if a.Contract != b.Contract {
+			return a.Contract < b.Contract
+		}
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		return a.Line < b.Line
+	})
+
+	return profile
+}
+
+func (p *Profiler) contractInfo(contract *vm.Contract) *contractInfo {
+	if info, ok := p.contracts[contract.CodeHash]; ok {
+		return info
+	}
+
+	// Delegate calls run the code of another address than their own.
+	address := contract.Address()
+	if contract.CodeAddr != nil {
+		address = *contract.CodeAddr
+	}
+
+	code := "0x" + hex.EncodeToString(contract.Code)
+	info := &contractInfo{
+		name:      p.source.GetName(address.Hex(), code),
+		sourceMap: p.source.GetSourceMap(address.Hex(), code),
+	}
+
+	p.contracts[contract.CodeHash] = info
+	return info
+}
+
+// enter updates the internal function stack of the frame before the opcode
+// is attributed and returns the source file and line of the opcode, or a zero
+// line if it doesn't have one.
+func (p *Profiler) enter(f *frame, contract *vm.Contract, pc uint64, op vm.OpCode) (string, int) {
+	if op == vm.JUMPDEST {
+		node := contract.Ast[uint(pc)]
+		if node != nil && node.NodeType == "FunctionDefinition" {
+			top := f.functions[len(f.functions)-1]
+			if f.entering || top.name == UnknownFunction {
+				top.name = node.Name
+			}
+		}
+	}
+	f.entering = false
+
+	mapping := f.sourceMap.At(int(pc))
+	if mapping == nil || mapping.Generated || mapping.Line == 0 {
+		return "", 0
+	}
+
+	top := f.functions[len(f.functions)-1]
+	top.file, top.line = mapping.File, mapping.Line
+	return mapping.File, mapping.Line
+}
+
+// leave updates the internal function stack of the frame after the opcode is
+// attributed, so jumps are charged to the function they are made from.
+func (p *Profiler) leave(f *frame, pc uint64, op vm.OpCode) {
+	if op != vm.JUMP {
+		return
+	}
+
+	mapping := f.sourceMap.At(int(pc))
+	if mapping == nil {
+		return
+	}
+
+	switch mapping.Jump {
+	case "i":
+		f.functions = append(f.functions, &function{name: UnknownFunction})
+		f.entering = true
+	case "o":
+		if len(f.functions) > 1 {
+			f.functions = f.functions[:len(f.functions)-1]
+		}
+	}
+}
+
+// attribute captures the contracts, functions and lines the opcode about to
+// be executed is charged to. The last entry of every list is the one the gas
+// is exclusive to.
+func (p *Profiler) attribute(gas, cost uint64, file string, line int) *step {
+	s := &step{
+		gas:     gas,
+		cost:    cost,
+		charged: p.charged,
+	}
+
+	seenContracts := make(map[string]bool)
+	seenFunctions := make(map[functionKey]bool)
+	seenLines := make(map[lineKey]bool)
+	for _, f := range p.frames {
+		if !seenContracts[f.contract] {
+			seenContracts[f.contract] = true
+			s.contracts = append(s.contracts, f.contract)
+		}
+
+		for _, fn := range f.functions {
+			key := functionKey{f.contract, fn.name}
+			if !seenFunctions[key] {
+				seenFunctions[key] = true
+				s.functions = append(s.functions, key)
+			}
+
+			site := lineKey{f.contract, fn.file, fn.line}
+			if fn.line > 0 && !seenLines[site] {
+				seenLines[site] = true
+				s.lines = append(s.lines, site)
+			}
+		}
+	}
+
+	// The gas is exclusive to the innermost entries even when they already
+	// appear further up the stack because of recursion. They are among the
+	// entries collected above, the innermost function being at the line.
+	top := p.frames[len(p.frames)-1]
+	fn := top.functions[len(top.functions)-1]
+	function := functionKey{top.contract, fn.name}
+	moveLast(s.contracts, func(i int) bool { return s.contracts[i] == top.contract })
+	moveLast(s.functions, func(i int) bool { return s.functions[i] == function })
+	if line > 0 {
+		site := lineKey{top.contract, file, line}
+		moveLast(s.lines, func(i int) bool { return s.lines[i] == site })
+	} else {
+		s.lines = append(s.lines, lineKey{})
+	}
+
+	return s
+}
+
+func (p *Profiler) charge(s *step, gas uint64) {
+	p.charged += gas
+
+	for i, contract := range s.contracts {
+		total := p.contractGas[contract]
+		if total == nil {
+			total = &Gas{}
+			p.contractGas[contract] = total
+		}
+		total.Inclusive += gas
+		if i == len(s.contracts)-1 {
+			total.Exclusive += gas
+		}
+	}
+
+	for i, key := range s.functions {
+		total := p.functionGas[key]
+		if total == nil {
+			total = &Gas{}
+			p.functionGas[key] = total
+		}
+		total.Inclusive += gas
+		if i == len(s.functions)-1 {
+			total.Exclusive += gas
+		}
+	}
+
+	for i, key := range s.lines {
+		// Opcodes without a source line only count towards their callers.
+		if key.line == 0 {
+			continue
+		}
+
+		total := p.lineGas[key]
+		if total == nil {
+			total = &Gas{}
+			p.lineGas[key] = total
+		}
+		total.Inclusive += gas
+		if i == len(s.lines)-1 {
+			total.Exclusive += gas
+		}
+	}
+}
+
+func (p *Profiler) popFrame() {
+	f := p.frames[len(p.frames)-1]
+	if f.pending != nil {
+		p.charge(f.pending, f.pending.cost)
+	}
+
+	p.frames = p.frames[:len(p.frames)-1]
+}
+
+// moveLast moves the first element of the slice the predicate matches to its
+// end, keeping the order of the others. Like sort.Slice, the predicate is
+// given the index of the element.
+func moveLast(slice interface{}, match func(i int) bool) {
+	swap := reflect.Swapper(slice)
+	n := reflect.ValueOf(slice).Len()
+	for i := 0; i < n; i++ {
+		if !match(i) {
+			continue
+		}
+
+		for ; i < n-1; i++ {
+			swap(i, i+1)
+		}
+		return
+	}
+}
@@ -0,0 +1,170 @@
+package profiler
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tenderly/tenderly-trace/ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
+	"github.com/tenderly/tenderly-trace/ethereum/signer/accounts/abi"
+	"github.com/tenderly/tenderly-trace/source"
+)
+
+// testContract maps every instruction of its code to a line of its file, with
+// the jump markers of the source map given per instruction.
+type testContract struct {
+	name  string
+	file  string
+	code  []byte
+	lines []int
+	jumps map[int]string
+}
+
+func (c *testContract) GetContractName() string                            { return c.name }
+func (c *testContract) GetContractAst(source.SourceMap) types.Ast          { return nil }
+func (c *testContract) GetContractStateVariables() []*types.Node           { return nil }
+func (c *testContract) GetContractDefinitions() types.Definitions          { return nil }
+func (c *testContract) GetContractStorageLayout() *types.StorageLayout     { return nil }
+func (c *testContract) GetContractAbi() *abi.ABI                           { return nil }
+func (c *testContract) GetContractDeployedBytecode() string                { return "0x" + hex.EncodeToString(c.code) }
+func (c *testContract) GetContractImmutableReferences() []source.CodeRange { return nil }
+
+func (c *testContract) GetContractSourceMap() (source.SourceMap, error) {
+	sourceMap := make(source.SourceMap, len(c.code))
+	for pc, line := range c.lines {
+		sourceMap[pc] = &source.InstructionMapping{Index: pc, File: c.file, Line: line, Jump: c.jumps[pc]}
+	}
+
+	return sourceMap, nil
+}
+
+func (c *testContract) vmContract(address common.Address, ast types.Ast) *vm.Contract {
+	contract := vm.NewContract(vm.AccountRef(address), vm.AccountRef(address), big.NewInt(0), 0)
+	contract.Code = c.code
+	contract.CodeHash = crypto.Keccak256Hash(c.code)
+	contract.Ast = ast
+	return contract
+}
+
+// testStep is an opcode executed at a call depth, with the gas remaining
+// before it.
+type testStep struct {
+	contract *vm.Contract
+	depth    int
+	pc       uint64
+	op       vm.OpCode
+	gas      uint64
+	cost     uint64
+}
+
+// runProfile runs the steps of a transaction calling from Caller into Callee,
+// which reverts:
+//
+//	Caller.run (A.sol:1-3) calls the internal Caller.helper (A.sol:5-6) and
+//	then Callee (B.sol:1-2).
+func runProfile(t *testing.T) *Profile {
+	t.Helper()
+
+	caller := &testContract{
+		name:  "Caller",
+		file:  "A.sol",
+		code:  []byte{byte(vm.JUMPDEST), byte(vm.JUMP), byte(vm.JUMPDEST), byte(vm.JUMP), byte(vm.CALL), byte(vm.STOP)},
+		lines: []int{1, 2, 5, 6, 3, 3},
+		jumps: map[int]string{1: "i", 3: "o"},
+	}
+	callee := &testContract{
+		name:  "Callee",
+		file:  "B.sol",
+		code:  []byte{byte(vm.PUSH1), byte(vm.REVERT)},
+		lines: []int{1, 2},
+	}
+
+	contracts := source.NewContractSource(map[string]source.Contract{
+		caller.GetContractDeployedBytecode(): caller,
+		callee.GetContractDeployedBytecode(): callee,
+	}, nil)
+
+	a := caller.vmContract(common.HexToAddress("0xaa"), types.Ast{
+		0: {NodeType: "FunctionDefinition", Name: "run"},
+		2: {NodeType: "FunctionDefinition", Name: "helper"},
+	})
+	b := callee.vmContract(common.HexToAddress("0xbb"), nil)
+
+	steps := []testStep{
+		{a, 1, 0, vm.JUMPDEST, 1000, 1},
+		{a, 1, 1, vm.JUMP, 999, 8},
+		{a, 1, 2, vm.JUMPDEST, 991, 1},
+		{a, 1, 3, vm.JUMP, 990, 8},
+		{a, 1, 4, vm.CALL, 982, 700},
+		{b, 2, 0, vm.PUSH1, 500, 3},
+		{b, 2, 1, vm.REVERT, 497, 5},
+		{a, 1, 5, vm.STOP, 882, 0},
+	}
+
+	profiler := New(contracts)
+	profiler.CaptureStart(nil, common.Address{}, a.Address(), false, nil, 1000, big.NewInt(0))
+	for _, step := range steps {
+		profiler.CaptureState(nil, step.pc, step.op, step.gas, step.cost, nil, nil, step.contract, step.depth, nil)
+	}
+	profiler.CaptureEnd(nil, 118, 0, nil)
+
+	return profiler.Profile()
+}
+
+func TestProfile(t *testing.T) {
+	result := runProfile(t)
+
+	// The call is charged with the gas it sent minus what the callee spent,
+	// and the callee's last opcode with its own cost.
+	if result.Total != 118 {
+		t.Errorf("expected 118 gas in total, got %d", result.Total)
+	}
+
+	contracts := make(map[string]Gas)
+	for _, entry := range result.Contracts {
+		contracts[entry.Contract] = entry.Gas
+	}
+	functions := make(map[string]Gas)
+	for _, entry := range result.Functions {
+		functions[entry.Contract+"."+entry.Function] = entry.Gas
+	}
+	lines := make(map[lineKey]Gas)
+	for _, entry := range result.Lines {
+		lines[lineKey{entry.Contract, entry.File, entry.Line}] = entry.Gas
+	}
+
+	tests := []struct {
+		name     string
+		gas      Gas
+		expected Gas
+	}{
+		{"contract Caller", contracts["Caller"], Gas{Inclusive: 118, Exclusive: 110}},
+		{"contract Callee", contracts["Callee"], Gas{Inclusive: 8, Exclusive: 8}},
+		{"function Caller.run", functions["Caller.run"], Gas{Inclusive: 118, Exclusive: 101}},
+		{"function Caller.helper", functions["Caller.helper"], Gas{Inclusive: 9, Exclusive: 9}},
+		{"function Callee." + UnknownFunction, functions["Callee."+UnknownFunction], Gas{Inclusive: 8, Exclusive: 8}},
+		{"line A.sol:1", lines[lineKey{"Caller", "A.sol", 1}], Gas{Inclusive: 1, Exclusive: 1}},
+		// The jump into helper is the call site of its lines.
+		{"line A.sol:2", lines[lineKey{"Caller", "A.sol", 2}], Gas{Inclusive: 17, Exclusive: 8}},
+		{"line A.sol:5", lines[lineKey{"Caller", "A.sol", 5}], Gas{Inclusive: 1, Exclusive: 1}},
+		{"line A.sol:6", lines[lineKey{"Caller", "A.sol", 6}], Gas{Inclusive: 8, Exclusive: 8}},
+		{"line A.sol:3", lines[lineKey{"Caller", "A.sol", 3}], Gas{Inclusive: 100, Exclusive: 92}},
+		{"line B.sol:1", lines[lineKey{"Callee", "B.sol", 1}], Gas{Inclusive: 3, Exclusive: 3}},
+		{"line B.sol:2", lines[lineKey{"Callee", "B.sol", 2}], Gas{Inclusive: 5, Exclusive: 5}},
+	}
+	for _, test := range tests {
+		if test.gas != test.expected {
+			t.Errorf("%s: expected %+v, got %+v", test.name, test.expected, test.gas)
+		}
+	}
+
+	if len(result.Lines) != 7 {
+		t.Errorf("expected 7 lines, got %d", len(result.Lines))
+	}
+	if first := result.Contracts[0]; first.Contract != "Caller" {
+		t.Errorf("expected the contracts sorted by exclusive gas, got %s first", first.Contract)
+	}
+}
@@ -0,0 +1,89 @@
+package profiler
+
+import (
+	"encoding/json"
+	"io"
+)
+
+const speedscopeSchema = "https://www.speedscope.app/file-format-schema.json"
+
+type speedscopeFrame struct {
+	Name string `json:"name"`
+}
+
+type speedscopeShared struct {
+	Frames []speedscopeFrame `json:"frames"`
+}
+
+type speedscopeProfile struct {
+	Type       string   `json:"type"`
+	Name       string   `json:"name"`
+	Unit       string   `json:"unit"`
+	StartValue uint64   `json:"startValue"`
+	EndValue   uint64   `json:"endValue"`
+	Samples    [][]int  `json:"samples"`
+	Weights    []uint64 `json:"weights"`
+}
+
+type speedscopeFile struct {
+	Schema   string              `json:"$schema"`
+	Name     string              `json:"name"`
+	Exporter string              `json:"exporter"`
+	Shared   speedscopeShared    `json:"shared"`
+	Profiles []speedscopeProfile `json:"profiles"`
+}
+
+// WriteSpeedscope writes the tree as a speedscope document with a gas and an
+// opcode profile. Samples follow the execution order, so the time order view
+// of speedscope shows the transaction as it was executed.
+func WriteSpeedscope(w io.Writer, root *Frame, name string) error {
+	file := speedscopeFile{
+		Schema:   speedscopeSchema,
+		Name:     name,
+		Exporter: "tenderly-trace",
+		Shared: speedscopeShared{
+			Frames: []speedscopeFrame{},
+		},
+	}
+
+	frames := make(map[string]int)
+	frameIndex := func(name string) int {
+		if index, ok := frames[name]; ok {
+			return index
+		}
+
+		frames[name] = len(file.Shared.Frames)
+		file.Shared.Frames = append(file.Shared.Frames, speedscopeFrame{Name: name})
+		return frames[name]
+	}
+
+	for _, sample := range []Sample{GasSample, OpcodeSample} {
+		profile := speedscopeProfile{
+			Type:    "sampled",
+			Name:    name + " " + sample.String(),
+			Unit:    "none",
+			Samples: [][]int{},
+			Weights: []uint64{},
+		}
+
+		root.Walk(func(stack []*Frame) {
+			value := stack[len(stack)-1].Value(sample)
+			if value == 0 {
+				return
+			}
+
+			indexes := make([]int, len(stack))
+			for i, frame := range stack {
+				indexes[i] = frameIndex(foldedName(frame.Name))
+			}
+
+			profile.Samples = append(profile.Samples, indexes)
+			profile.Weights = append(profile.Weights, value)
+			profile.EndValue += value
+		})
+
+		file.Profiles = append(file.Profiles, profile)
+	}
+
+	return json.NewEncoder(w).Encode(file)
+}
@@ -0,0 +1,126 @@
+package profiler
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Frame is a node of an execution tree, either an external call or an internal
+// function call. Gas and Opcodes are exclusive to the frame, the ones spent by
+// its callees are accounted in their own frames.
+type Frame struct {
+	Name     string
+	Gas      uint64
+	Opcodes  uint64
+	Children []*Frame
+}
+
+// Sample selects the value of a frame that gets exported.
+type Sample int
+
+const (
+	GasSample Sample = iota
+	OpcodeSample
+)
+
+func (s Sample) String() string {
+	switch s {
+	case GasSample:
+		return "gas"
+	case OpcodeSample:
+		return "opcodes"
+	}
+
+	return fmt.Sprintf("sample(%d)", int(s))
+}
+
+// Value returns the exclusive value of the frame for the given sample.
+func (f *Frame) Value(sample Sample) uint64 {
+	if sample == OpcodeSample {
+		return f.Opcodes
+	}
+
+	return f.Gas
+}
+
+// Walk calls fn for every frame of the tree in execution order, together with
+// the frames leading to it, the root first and the frame itself last.
+func (f *Frame) Walk(fn func(stack []*Frame)) {
+	f.walk(nil, fn)
+}
+
+func (f *Frame) walk(stack []*Frame, fn func(stack []*Frame)) {
+	stack = append(stack, f)
+	fn(stack)
+
+	for _, child := range f.Children {
+		child.walk(stack, fn)
+	}
+}
+
+// callFrame is a call of the tree reported by the callTracerFinal tracer.
+type callFrame struct {
+	Func    string      `json:"func"`
+	Type    string      `json:"type"`
+	To      string      `json:"to"`
+	GasUsed string      `json:"gasUsed"`
+	Opcodes uint64      `json:"opcodes"`
+	Calls   []callFrame `json:"calls"`
+}
+
+// FromCallTrace builds the execution tree from the result of the
+// callTracerFinal tracer, where internal function calls are reported as
+// JUMPDEST calls.
+func FromCallTrace(result json.RawMessage) (*Frame, error) {
+	var root callFrame
+	err := json.Unmarshal(result, &root)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing call trace, err: %s", err)
+	}
+
+	frame, _ := root.frame()
+	return frame, nil
+}
+
+// frame converts the call and returns it together with its inclusive gas.
+func (c callFrame) frame() (*Frame, uint64) {
+	frame := &Frame{
+		Name:    c.name(),
+		Opcodes: c.Opcodes,
+	}
+
+	// Reported gas can be missing, like for calls to plain accounts, or
+	// negative when the tracer couldn't follow the call.
+	gasUsed, _ := strconv.ParseUint(strings.TrimPrefix(c.GasUsed, "0x"), 16, 64)
+
+	var calleesGas uint64
+	for _, call := range c.Calls {
+		child, gas := call.frame()
+		frame.Children = append(frame.Children, child)
+		calleesGas += gas
+	}
+
+	if gasUsed > calleesGas {
+		frame.Gas = gasUsed - calleesGas
+	}
+
+	return frame, gasUsed
+}
+
+func (c callFrame) name() string {
+	if c.Type == "JUMPDEST" {
+		return c.Func
+	}
+
+	name := c.To
+	if name == "" {
+		name = c.Type
+	}
+	if c.Func != "" {
+		name += "." + c.Func
+	}
+
+	return name
+}
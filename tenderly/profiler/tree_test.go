@@ -0,0 +1,139 @@
+package profiler
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/pprof/profile"
+)
+
+// callTrace loads the tree of testdata/call_trace.json, a call into run of
+// 0xaa which calls its internal helper function, calls 0xbb which reverts and
+// statically calls balanceOf of 0xcc without spending any gas.
+func callTrace(t *testing.T) *Frame {
+	t.Helper()
+
+	blob, err := ioutil.ReadFile(filepath.Join("testdata", "call_trace.json"))
+	if err != nil {
+		t.Fatalf("failed to read call trace: %v", err)
+	}
+
+	root, err := FromCallTrace(blob)
+	if err != nil {
+		t.Fatalf("failed to build tree: %v", err)
+	}
+
+	return root
+}
+
+// checkGolden compares the output with the contents of the golden file.
+func checkGolden(t *testing.T, output []byte, golden string) {
+	t.Helper()
+
+	want, err := ioutil.ReadFile(filepath.Join("testdata", golden))
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+	if !bytes.Equal(output, want) {
+		t.Errorf("output mismatch with %s\nhave: %q\nwant: %q", golden, output, want)
+	}
+}
+
+func TestFromCallTrace(t *testing.T) {
+	want := &Frame{
+		Name:    "0x00000000000000000000000000000000000000aa.run",
+		Gas:     101,
+		Opcodes: 6,
+		Children: []*Frame{
+			{Name: "helper", Gas: 9, Opcodes: 2},
+			{Name: "0x00000000000000000000000000000000000000bb", Gas: 8, Opcodes: 2},
+			{Name: "0x00000000000000000000000000000000000000cc.balanceOf"},
+		},
+	}
+
+	if root := callTrace(t); !reflect.DeepEqual(root, want) {
+		t.Errorf("tree mismatch\nhave: %+v\nwant: %+v", root, want)
+	}
+}
+
+func TestWriteFolded(t *testing.T) {
+	root := callTrace(t)
+
+	tests := []struct {
+		sample Sample
+		golden string
+	}{
+		{GasSample, "call_trace_gas.folded"},
+		{OpcodeSample, "call_trace_opcodes.folded"},
+	}
+	for _, test := range tests {
+		var output bytes.Buffer
+		err := WriteFolded(&output, root, test.sample)
+		if err != nil {
+			t.Fatalf("failed to write %s folded stacks: %v", test.sample, err)
+		}
+
+		checkGolden(t, output.Bytes(), test.golden)
+	}
+}
+
+func TestWritePprof(t *testing.T) {
+	var output bytes.Buffer
+	err := WritePprof(&output, callTrace(t))
+	if err != nil {
+		t.Fatalf("failed to write pprof profile: %v", err)
+	}
+
+	p, err := profile.Parse(&output)
+	if err != nil {
+		t.Fatalf("failed to parse pprof profile: %v", err)
+	}
+
+	var sampleTypes []string
+	for _, sampleType := range p.SampleType {
+		sampleTypes = append(sampleTypes, sampleType.Type+"/"+sampleType.Unit)
+	}
+	if strings.Join(sampleTypes, ",") != "gas/count,opcodes/count" || p.DefaultSampleType != "gas" {
+		t.Errorf("unexpected sample types %v, default %q", sampleTypes, p.DefaultSampleType)
+	}
+
+	// Every frame name has a single location calling a single function.
+	if len(p.Location) != 3 || len(p.Function) != 3 {
+		t.Errorf("expected 3 locations and functions, got %d and %d", len(p.Location), len(p.Function))
+	}
+	for _, location := range p.Location {
+		if len(location.Line) != 1 || location.Line[0].Function == nil || location.Line[0].Function.ID != location.ID {
+			t.Errorf("expected location %d to call function %d", location.ID, location.ID)
+		}
+	}
+
+	// The samples match the folded stacks, starting with the innermost frame.
+	var gas, opcodes bytes.Buffer
+	for _, sample := range p.Sample {
+		names := make([]string, len(sample.Location))
+		for i, location := range sample.Location {
+			names[len(names)-1-i] = location.Line[0].Function.Name
+		}
+
+		stack := strings.Join(names, ";")
+		fmt.Fprintf(&gas, "%s %d\n", stack, sample.Value[0])
+		fmt.Fprintf(&opcodes, "%s %d\n", stack, sample.Value[1])
+	}
+	checkGolden(t, gas.Bytes(), "call_trace_gas.folded")
+	checkGolden(t, opcodes.Bytes(), "call_trace_opcodes.folded")
+}
+
+func TestWriteSpeedscope(t *testing.T) {
+	var output bytes.Buffer
+	err := WriteSpeedscope(&output, callTrace(t), "run")
+	if err != nil {
+		t.Fatalf("failed to write speedscope document: %v", err)
+	}
+
+	checkGolden(t, output.Bytes(), "call_trace.speedscope.json")
+}
@@ -0,0 +1,150 @@
+package tenderly
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum"
+	"github.com/tenderly/tenderly-trace/ethereum/core/vm"
+	"github.com/tenderly/tenderly-trace/source"
+)
+
+// Call is an ad-hoc message to simulate, which doesn't have to be signed.
+type Call struct {
+	From common.Address
+	// To is the called contract, or nil for a contract creation.
+	To    *common.Address
+	Input []byte
+	Value *big.Int
+	// Gas is the gas available to the call, the gas limit of the block when
+	// zero.
+	Gas      uint64
+	GasPrice *big.Int
+	// Block is the block the call is simulated in, the one following the
+	// latest block when zero. The call sees the state the block started from,
+	// so by default the state after the latest block.
+	Block int64
+}
+
+// Simulate executes the call on top of the state its block started from and
+// returns the result reported by the tracer selected in the options. Nothing
+// is sent to the node.
+func (t Tenderly) Simulate(call *Call, cs source.Source, opts *TraceOptions) (json.RawMessage, error) {
+	tracer, err := newTracer(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.simulate(call, cs, tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := tracer.GetResult()
+	if err != nil {
+		return nil, fmt.Errorf("failed tracing simulated call, err: %s", err)
+	}
+
+	return results, nil
+}
+
+// simulate executes the call, reporting every executed step to the tracer.
+func (t Tenderly) simulate(call *Call, cs source.Source, tracer vm.Tracer) error {
+	blockHeader, err := t.simulationBlock(call.Block)
+	if err != nil {
+		return err
+	}
+
+	gas := call.Gas
+	if gas == 0 {
+		gas = blockHeader.GasLimit().ToInt().Uint64()
+	}
+	value := call.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	gasPrice := call.GasPrice
+	if gasPrice == nil {
+		gasPrice = new(big.Int)
+	}
+
+	message := types.NewMessage(call.From, call.To, 0, value, gas, gasPrice, call.Input, false)
+
+	_, err = t.execute(message, blockHeader, cs, tracer)
+	if err != nil {
+		return fmt.Errorf("failed simulating call, err: %s", err)
+	}
+
+	return nil
+}
+
+// simulationBlock returns the header of the block to simulate in, the pending
+// block following the latest one when number is zero.
+func (t Tenderly) simulationBlock(number int64) (ethereum.BlockHeader, error) {
+	pending := number == 0
+	if pending {
+		var err error
+		number, err = t.client.CurrentBlockNumber()
+		if err != nil {
+			return nil, fmt.Errorf("failed fetching latest block, err: %s", err)
+		}
+	}
+
+	blockHeader, err := t.client.GetBlockHeader(number)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching block %d, err: %s", number, err)
+	}
+
+	if pending {
+		return newPendingBlockHeader(blockHeader), nil
+	}
+
+	return blockHeader, nil
+}
+
+// pendingBlockHeader is the header of the block a node would mine next on top
+// of the latest one, which it takes the beneficiary, difficulty and gas limit
+// from.
+type pendingBlockHeader struct {
+	ethereum.BlockHeader
+
+	number ethereum.Number
+	time   *hexutil.Big
+}
+
+func newPendingBlockHeader(latest ethereum.BlockHeader) *pendingBlockHeader {
+	// Like miners do, the timestamp is the current time unless that's not
+	// past the latest block.
+	timestamp := big.NewInt(time.Now().Unix())
+	if next := new(big.Int).Add(latest.Time().ToInt(), big.NewInt(1)); timestamp.Cmp(next) < 0 {
+		timestamp = next
+	}
+
+	return &pendingBlockHeader{
+		BlockHeader: latest,
+		number:      ethereum.Number(latest.Number().Value() + 1),
+		time:        (*hexutil.Big)(timestamp),
+	}
+}
+
+func (h *pendingBlockHeader) Number() *ethereum.Number {
+	return &h.number
+}
+
+// Hash returns nil, the pending block has no hash yet.
+func (h *pendingBlockHeader) Hash() *common.Hash {
+	return nil
+}
+
+func (h *pendingBlockHeader) ParentHash() *common.Hash {
+	return h.BlockHeader.Hash()
+}
+
+func (h *pendingBlockHeader) Time() *hexutil.Big {
+	return h.time
+}
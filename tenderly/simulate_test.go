@@ -0,0 +1,40 @@
+package tenderly
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum"
+	"github.com/tenderly/tenderly-trace/ethereum/geth"
+)
+
+func TestPendingBlockHeader(t *testing.T) {
+	number := ethereum.Number(100)
+	hash := common.HexToHash("0x01")
+	latest := &geth.BlockHeader{
+		ValueNumber:     &number,
+		ValueBlockHash:  &hash,
+		ValueParentHash: &common.Hash{},
+		// A timestamp far in the future, which the pending block has to follow.
+		ValueTime:       (*hexutil.Big)(big.NewInt(1 << 40)),
+		ValueDifficulty: (*hexutil.Big)(big.NewInt(2)),
+		ValueGasLimit:   (*hexutil.Big)(big.NewInt(8000000)),
+		ValueCoinbase:   &testReceiver,
+	}
+
+	// The call runs in block 101, on the state block 100 left behind.
+	context := buildContext(types.NewMessage(testSender, &testToken, 0, new(big.Int), 0, new(big.Int), nil, false), newPendingBlockHeader(latest))
+	if context.BlockNumber.Int64() != 101 {
+		t.Errorf("expected block 101, got %s", context.BlockNumber)
+	}
+	if context.Time.Int64() != 1<<40+1 {
+		t.Errorf("expected time %d, got %s", int64(1<<40+1), context.Time)
+	}
+	if context.GasLimit != 8000000 || context.Difficulty.Int64() != 2 || context.Coinbase != testReceiver {
+		t.Errorf("expected the gas limit, difficulty and coinbase of the latest block, got %d, %s and %s",
+			context.GasLimit, context.Difficulty, context.Coinbase.Hex())
+	}
+}
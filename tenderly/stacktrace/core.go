@@ -1,11 +1,13 @@
 package stacktrace
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/tenderly/tenderly-trace/ethereum"
 	"github.com/tenderly/tenderly-trace/ethereum/parity"
 )
@@ -31,6 +33,28 @@ type ContractDetails struct {
 	Source    string
 	SourceMap SourceMap
 	ProjectID string
+
+	// Sources are all sources of the build the contract was compiled in,
+	// keyed by their file index.
+	Sources map[int]*SourceFile
+}
+
+// code returns the source code the instruction maps to, or an empty string if
+// its source isn't known. Contracts without the sources of their build, like
+// ones compiled from a single file, map into their own source.
+func (cd *ContractDetails) code(im *InstructionMapping) string {
+	content := cd.Source
+	if file, ok := cd.Sources[im.FileIndex]; ok {
+		content = file.Content
+	} else if im.Generated || im.FileIndex < 0 {
+		return ""
+	}
+
+	if im.Start < 0 || im.Length < 0 || im.Start+im.Length > len(content) {
+		return ""
+	}
+
+	return content[im.Start : im.Start+im.Length]
 }
 
 type ContractSource interface {
@@ -106,13 +130,14 @@ func (c *Core) GenerateStackTrace(contractHash string, txTrace ethereum.Transact
 		switch op {
 		case ethereum.CALL:
 			stack := state.Stack()
-			if stack == nil {
+			if stack == nil || len(stack.Data()) < 2 {
 				log.Println("didn't find stack but expected one: ", contractHash)
 				c.stack.Push(contract)
 				break
 			}
 
-			newAddress := "0x" + stack[len(stack)-2][24:]
+			data := stack.Data()
+			newAddress := "0x" + hex.EncodeToString(common.BigToAddress(data[len(data)-2]).Bytes())
 
 			newContract, err := c.Contracts.Get(newAddress)
 			if err != nil {
@@ -138,23 +163,26 @@ func (c *Core) GenerateStackTrace(contractHash string, txTrace ethereum.Transact
 			recordStackFrames = true
 		}
 
-		im := contract.SourceMap[int(state.Pc())]
+		im := contract.SourceMap.At(int(state.Pc()))
 		if im == nil {
 			//@TODO: Abort, with error message.
 			log.Printf("MISSING SOURCE MAP: %s %d", contractHash, int(state.Pc()))
 			continue
 		}
 
-		if im.FileIndex == -1 {
-			//@TODO: Check if reverts keep getting excluded due to this.
-			//fmt.Printf("INTERNAL => %d\t%s\n", state.Pc(), op.String())
-			continue
+		// Reverts in compiler generated code, like failed checks of
+		// arguments and arithmetic, are kept, without source code.
+		code := contract.code(im)
+		file := im.File
+		switch {
+		case im.Generated:
+			file = "<generated>"
+		case file == "":
+			file = contract.Name
 		}
 
-		code := string(contract.Source[im.Start : im.Start+im.Length])
-
 		frame := &Frame{
-			File: contract.Name,
+			File: file,
 
 			Line:   im.Line,
 			Column: im.Column,
@@ -180,7 +208,7 @@ func (c *Core) GenerateStackTrace(contractHash string, txTrace ethereum.Transact
 				ContractName:    contract.Name,
 				Line:            frame.Line,
 
-				Code:   string(contract.Source[im.Start : im.Start+im.Length]),
+				Code:   code,
 				Op:     op.String(),
 				Start:  frame.Start,
 				Length: frame.Length,
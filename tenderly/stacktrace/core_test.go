@@ -0,0 +1,53 @@
+package stacktrace
+
+import (
+	"testing"
+
+	"github.com/tenderly/tenderly-trace/ethereum"
+	"github.com/tenderly/tenderly-trace/ethereum/geth"
+)
+
+type testContracts map[string]*ContractDetails
+
+func (c testContracts) Get(id string) (*ContractDetails, error) {
+	contract, ok := c[id]
+	if !ok {
+		return nil, ErrNotExist
+	}
+
+	return contract, nil
+}
+
+// Contracts compiled from a single file come without the sources of their
+// build, the code of their frames is taken from their own source.
+func TestGenerateStackTraceSingleFile(t *testing.T) {
+	source := "contract Token {\n  function f() public { revert(); }\n}"
+
+	function := &InstructionMapping{Start: 19, Length: 34, Line: 2, Column: 3}
+	revert := &InstructionMapping{Start: 41, Length: 8, Line: 2, Column: 25}
+	contract := &ContractDetails{
+		Name: "Token",
+		Hash: "0x00000000000000000000000000000000000000aa",
+		// PUSH1 0x00 PUSH1 0x00 REVERT
+		Bytecode:  []byte{byte(ethereum.PUSH1), 0x00, byte(ethereum.PUSH1), 0x00, byte(ethereum.REVERT)},
+		Source:    source,
+		SourceMap: SourceMap{function, nil, function, nil, revert},
+	}
+
+	trace := &geth.TraceResult{
+		StructLogs: []*geth.EvmState{{ValuePc: 0}, {ValuePc: 2}, {ValuePc: 4}},
+	}
+
+	core := NewCore(testContracts{contract.Hash: contract})
+	frames, err := core.GenerateStackTrace(contract.Hash, trace)
+	if err != nil {
+		t.Fatalf("failed generating stack trace: %s", err)
+	}
+
+	if len(frames) != 1 {
+		t.Fatalf("expected 1 frame, got %d", len(frames))
+	}
+	if frame := frames[0]; frame.Code != "revert()" || frame.Line != 2 || frame.ContractName != "Token" {
+		t.Errorf("unexpected frame %+v", frame)
+	}
+}
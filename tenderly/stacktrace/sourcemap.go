@@ -3,14 +3,12 @@ package stacktrace
 import (
 	"encoding/hex"
 	"fmt"
-	"strconv"
 	"strings"
 
 	"github.com/tenderly/tenderly-trace/ethereum"
+	"github.com/tenderly/tenderly-trace/source"
 )
 
-//@TODO: Use a more memory efficient method of representing source maps. How costly are InstructionMapping refs?
-
 type InstructionMapping struct {
 	Start  int
 	Length int
@@ -19,80 +17,86 @@ type InstructionMapping struct {
 	Line   int
 	Column int
 
-	FileIndex int
-	Jump      string
+	// File is the path of the source the instruction maps into. Generated
+	// marks code the compiler generated, which maps into no source or into
+	// one of the compiler's own.
+	File          string
+	FileIndex     int
+	Generated     bool
+	Jump          string
+	ModifierDepth int
 }
 
-// SourceMap is the memory address to instruction information map.
-type SourceMap map[int]*InstructionMapping
+// SourceFile is a source of the compilation, keyed by its file index.
+type SourceFile struct {
+	Path    string
+	Content string
 
-func ParseSourceMap(sourceMap string, source string, bytecode string) (*SourceMap, error) {
-	instructionSrcMap := make(SourceMap)
+	lines *source.LineIndex
+}
 
-	var err error
-	var s int64
-	var l int64
-	var f int64
-	var j string
-	for index, mapping := range strings.Split(sourceMap, ";") {
-		if mapping == "" {
-			instructionSrcMap[index] = instructionSrcMap[index-1]
-			continue
-		}
+// Lines returns the line index of the source, built the first time it is
+// asked for.
+func (file *SourceFile) Lines() *source.LineIndex {
+	if file.lines == nil {
+		file.lines = source.NewLineIndex(file.Content)
+	}
 
-		info := strings.Split(mapping, ":")
-		if len(info) > 0 && info[0] != "" {
-			s, err = strconv.ParseInt(info[0], 0, 64)
-			if err != nil {
-				return nil, fmt.Errorf("failed parsing integer: %s", err)
-			}
-		}
-		if len(info) > 1 && info[1] != "" {
-			l, err = strconv.ParseInt(info[1], 0, 64)
-			if err != nil {
-				return nil, fmt.Errorf("failed parsing integer: %s", err)
-			}
-		}
-		if len(info) > 2 && info[2] != "" {
-			f, err = strconv.ParseInt(info[2], 0, 64)
-			if err != nil {
-				return nil, fmt.Errorf("failed parsing integer: %s", err)
-			}
-		}
-		if len(info) > 3 && info[3] != "" {
-			j = info[3]
-		}
+	return file.lines
+}
 
-		instructionSrcMap[index] = &InstructionMapping{
-			Start:     int(s),
-			Length:    int(l),
-			FileIndex: int(f),
-			Jump:      j,
-		}
+// SourceMap is the memory address to instruction information map. Its
+// entries are indexed by memory address, and instructions with the same
+// mapping share it.
+type SourceMap []*InstructionMapping
+
+// At returns the mapping of the instruction at the memory address, or nil if
+// it doesn't have one.
+func (sm SourceMap) At(pc int) *InstructionMapping {
+	if pc < 0 || pc >= len(sm) {
+		return nil
 	}
 
-	for _, instruction := range instructionSrcMap {
-		if instruction == nil {
-			// Instruction does not map to source
+	return sm[pc]
+}
+
+// ParseSourceMap resolves the source map against the sources of the
+// compilation, which are all sources of the build by their file index.
+// Instructions mapping into other sources are taken for generated code.
+func ParseSourceMap(sourceMap string, sources map[int]*SourceFile, bytecode string) (*SourceMap, error) {
+	entries, err := source.ParseCompactSourceMap(sourceMap)
+	if err != nil {
+		return nil, err
+	}
+
+	instructionSrcMap := make(SourceMap, len(entries))
+	mappings := make(map[source.SourceMapEntry]*InstructionMapping)
+	for index, entry := range entries {
+		if existing, ok := mappings[entry]; ok {
+			instructionSrcMap[index] = existing
 			continue
 		}
 
-		i := 0
-		line := 1
-		column := 1
-
-		for i < instruction.Start {
-			if source[i] == '\n' {
-				line++
-				column = 0
-			}
+		instructionSrcMap[index] = &InstructionMapping{
+			Start:         entry.Start,
+			Length:        entry.Length,
+			FileIndex:     entry.FileIndex,
+			Jump:          entry.Jump,
+			ModifierDepth: entry.ModifierDepth,
+		}
+		mappings[entry] = instructionSrcMap[index]
+	}
 
-			column++
-			i++
+	// Instructions share their mappings, which are resolved once each.
+	for _, instruction := range mappings {
+		file, ok := sources[instruction.FileIndex]
+		if !ok {
+			instruction.Generated = true
+			continue
 		}
 
-		instruction.Line = line
-		instruction.Column = column
+		instruction.File = file.Path
+		instruction.Line, instruction.Column = file.Lines().Position(instruction.Start)
 	}
 
 	memSrcMap, err := convertToMemoryMap(instructionSrcMap, bytecode)
@@ -104,8 +108,6 @@ func ParseSourceMap(sourceMap string, source string, bytecode string) (*SourceMa
 }
 
 func convertToMemoryMap(sourceMap SourceMap, binData string) (SourceMap, error) {
-	memSrcMap := make(SourceMap)
-
 	if strings.HasPrefix(binData, "0x") {
 		binData = binData[2:]
 	}
@@ -115,6 +117,8 @@ func convertToMemoryMap(sourceMap SourceMap, binData string) (SourceMap, error)
 		return nil, fmt.Errorf("failed decoding runtime binary: %s", err)
 	}
 
+	memSrcMap := make(SourceMap, len(bin))
+
 	instruction := 0
 	for i := 0; i < len(bin); i++ {
 
@@ -125,7 +129,9 @@ func convertToMemoryMap(sourceMap SourceMap, binData string) (SourceMap, error)
 			extraPush = int(op - ethereum.PUSH1 + 1)
 		}
 
-		memSrcMap[i] = sourceMap[instruction]
+		if instruction < len(sourceMap) {
+			memSrcMap[i] = sourceMap[instruction]
+		}
 
 		instruction++
 		i += extraPush
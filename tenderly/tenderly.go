@@ -9,7 +9,9 @@ type Tenderly struct {
 	client client.Client
 }
 
-//TODO: change contracts parameter to project folder root parameter and implement contract loading and framework detection
+// NewTenderly connects to the node at the given RPC target. Contracts are
+// passed to every trace as a source.Source, which project.Load builds from
+// the root of a truffle, hardhat, foundry or brownie project.
 func NewTenderly(target string) (*Tenderly, error) {
 	rpcClient, err := client.Dial(target)
 	if err != nil {
@@ -20,3 +22,13 @@ func NewTenderly(target string) (*Tenderly, error) {
 		client: *rpcClient,
 	}, nil
 }
+
+// NetworkID returns the id of the network the node is on.
+func (t Tenderly) NetworkID() (string, error) {
+	networkID, err := t.client.GetNetworkID()
+	if err != nil {
+		return "", fmt.Errorf("failed fetching network id, err: %s", err)
+	}
+
+	return networkID, nil
+}
@@ -1,6 +1,7 @@
 package tenderly
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
@@ -55,9 +56,7 @@ type Trace struct {
 	State               hexutil.Bytes
 	DecodedState        *[]core.DecodedArgument
 	Input               hexutil.Bytes
-	DecodedInput        *[]core.DecodedArgument
 	Output              hexutil.Bytes
-	DecodedOutput       *[]core.DecodedArgument
 	Locals              []hexutil.Bytes
 	DecodedLocals       *[]core.DecodedArgument
 	ParentLocals        []hexutil.Bytes
@@ -67,10 +66,92 @@ type Trace struct {
 	Trace               []Trace
 }
 
-func (t Tenderly) Trace(txHash string, cs source.Source) (*Trace, error) {
+// DefaultTracer is the tracer used when the trace options don't name one.
+const DefaultTracer = "callTracerFinal"
+
+// TraceOptions configure how a transaction is traced.
+type TraceOptions struct {
+	// Tracer is the name of a registered native tracer or a JavaScript tracer,
+	// either built in or loaded through tracers.LoadDir. Anything else is
+	// evaluated as JavaScript tracer code.
+	Tracer string
+	// TracerConfig is passed to the tracer when it is created.
+	TracerConfig json.RawMessage
+}
+
+// Trace re-executes the transaction and returns the result reported by the
+// tracer selected in the options.
+func (t Tenderly) Trace(txHash string, cs source.Source, opts *TraceOptions) (json.RawMessage, error) {
+	tracer, err := newTracer(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = t.replay(txHash, cs, tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := tracer.GetResult()
+	if err != nil {
+		return nil, fmt.Errorf("failed tracing transaction %s, err: %s", txHash, err)
+	}
+
+	return results, nil
+}
+
+// newTracer creates the tracer selected in the options.
+func newTracer(opts *TraceOptions) (tracers.ResultTracer, error) {
+	if opts == nil {
+		opts = &TraceOptions{}
+	}
+
+	name := opts.Tracer
+	if name == "" {
+		name = DefaultTracer
+	}
+
+	tracer, err := tracers.NewTracer(name, opts.TracerConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating tracer %s, err: %s", name, err)
+	}
+
+	return tracer, nil
+}
+
+// StructLogs re-executes the transaction and returns the opcode level logs in
+// the same format debug_traceTransaction does, so they can be diffed against
+// the node's own output.
+func (t Tenderly) StructLogs(txHash string, cs source.Source, cfg *vm.LogConfig) (*tracers.ExecutionResult, error) {
+	logger := vm.NewStructLogger(cfg)
+
+	exec, err := t.replay(txHash, cs, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tracers.ExecutionResult{
+		Gas:         exec.gasUsed,
+		Failed:      exec.failed,
+		ReturnValue: fmt.Sprintf("%x", exec.output),
+		StructLogs:  tracers.FormatLogs(logger.StructLogs()),
+	}, nil
+}
+
+// execution is the outcome of re-executing a transaction locally.
+type execution struct {
+	output  []byte
+	gasUsed uint64
+	failed  bool
+	logs    []*types.Log
+}
+
+// replay re-executes the transaction locally on top of the state of its block,
+// reporting every executed step to the given tracer.
+func (t Tenderly) replay(txHash string, cs source.Source, tracer vm.Tracer) (*execution, error) {
 	tx, err := t.client.GetTransaction(txHash)
 	if err != nil {
-		return nil, fmt.Errorf("failed fetching transaction %s, err: %s\n", txHash, err)
+		return nil, fmt.Errorf("failed fetching transaction %s, err: %s", txHash, err)
 	}
 
 	if tx.BlockNumber() == nil {
@@ -79,28 +160,51 @@ func (t Tenderly) Trace(txHash string, cs source.Source) (*Trace, error) {
 
 	blockHeader, err := t.client.GetBlockByHash(tx.BlockHash().String())
 	if err != nil {
-		return nil, fmt.Errorf("failed fetcing block %s, err: %s\n", tx.BlockNumber().String(), err)
+		return nil, fmt.Errorf("failed fetcing block %s, err: %s", tx.BlockNumber().String(), err)
 	}
 
-	tracer, err := tracers.New("callTracerFinal")
+	exec, err := t.execute(buildMessage(tx), blockHeader, cs, tracer)
+	if err != nil {
+		return nil, fmt.Errorf("failed applying transaction %s, err: %s", txHash, err)
+	}
+
+	return exec, nil
+}
 
-	context := buildContext(tx, blockHeader)
+// execute applies the message on top of the state the block started from,
+// reporting every executed step to the given tracer.
+func (t Tenderly) execute(message types.Message, blockHeader ethereum.BlockHeader, cs source.Source, tracer vm.Tracer) (*execution, error) {
+	context := buildContext(message, blockHeader)
 	stateDB := state.New(t.client, blockHeader.Number().Value(), cs.GetSource())
 	chainConfig := params.TestChainConfig
 	vmConfig := vm.Config{Debug: true, Tracer: tracer}
 
 	env := vm.NewEVM(context, stateDB, chainConfig, vmConfig)
-	env.Call(vm.AccountRef(*tx.From()), *tx.To(), tx.Input(), tx.Gas().ToInt().Uint64(), tx.Value().ToInt())
+	gasPool := new(core2.GasPool).AddGas(message.Gas())
 
-	results, err := tracer.GetResult()
-	fmt.Println(string(results))
+	ret, gasUsed, failed, err := core2.ApplyMessage(env, message, gasPool)
+	if err != nil {
+		return nil, err
+	}
+
+	if reporter, ok := tracer.(tracers.GasReporter); ok {
+		reporter.SetGasUsed(gasUsed)
+	}
 
-	return nil, nil
+	return &execution{
+		output:  ret,
+		gasUsed: gasUsed,
+		failed:  failed,
+		logs:    stateDB.Logs(),
+	}, nil
 }
 
-func buildContext(tx ethereum.Transaction, blockHeader ethereum.BlockHeader) vm.Context {
-	message := types.NewMessage(*tx.From(), tx.To(), 0, tx.Value().ToInt(), tx.Gas().ToInt().Uint64(),
+func buildMessage(tx ethereum.Transaction) types.Message {
+	return types.NewMessage(*tx.From(), tx.To(), 0, tx.Value().ToInt(), tx.Gas().ToInt().Uint64(),
 		tx.GasPrice().ToInt(), tx.Input(), false)
+}
+
+func buildContext(message types.Message, blockHeader ethereum.BlockHeader) vm.Context {
 	header := types.Header{
 		Number:     big.NewInt(blockHeader.Number().Value()),
 		ParentHash: *blockHeader.ParentHash(),
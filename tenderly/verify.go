@@ -0,0 +1,270 @@
+package tenderly
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/tenderly/tenderly-trace/ethereum"
+	"github.com/tenderly/tenderly-trace/ethereum/eth/tracers"
+	"github.com/tenderly/tenderly-trace/ethereum/geth"
+	"github.com/tenderly/tenderly-trace/ethereum/parity"
+	"github.com/tenderly/tenderly-trace/source"
+)
+
+// verifyTracer is the tracer whose output matches the node's call tracer.
+const verifyTracer = "callTracerOriginal"
+
+// Divergence describes the first point at which the local re-execution of a
+// transaction differs from the execution reported by the node.
+type Divergence struct {
+	// Path locates the diverging call in the local call tree, with the index of
+	// every nested call separated by a slash. It is empty for differences in
+	// the receipt.
+	Path  string
+	Field string
+	Local string
+	Node  string
+}
+
+func (d *Divergence) String() string {
+	location := "receipt"
+	if d.Path != "" {
+		location = "call " + d.Path
+	}
+
+	return fmt.Sprintf("%s: %s differs, local %s, node %s", location, d.Field, d.Local, d.Node)
+}
+
+// Verify re-executes the transaction locally and compares the call structure,
+// call outputs, logs, status and gas used against the node's own call trace and
+// receipt. It returns the first divergence found, or nil if both agree.
+func (t Tenderly) Verify(txHash string, cs source.Source) (*Divergence, error) {
+	tracer, err := tracers.NewTracer(verifyTracer, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating tracer %s, err: %s", verifyTracer, err)
+	}
+
+	exec, err := t.replay(txHash, cs, tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := tracer.GetResult()
+	if err != nil {
+		return nil, fmt.Errorf("failed tracing transaction %s, err: %s", txHash, err)
+	}
+
+	var localTrace geth.CallTrace
+	err = json.Unmarshal(result, &localTrace)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing local call trace, err: %s", err)
+	}
+
+	nodeTrace, err := t.client.GetTransactionCallTrace(txHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching call trace for transaction %s, err: %s", txHash, err)
+	}
+
+	receipt, err := t.client.GetTransactionReceipt(txHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching receipt for transaction %s, err: %s", txHash, err)
+	}
+
+	if divergence := compareCalls(callPaths(&localTrace, ""), nodePaths(nodeTrace)); divergence != nil {
+		return divergence, nil
+	}
+
+	if divergence := compareLogs(exec, receipt.Logs()); divergence != nil {
+		return divergence, nil
+	}
+
+	if status := receipt.Status(); status != "" {
+		nodeFailed := status == "0x0"
+		if exec.failed != nodeFailed {
+			return &Divergence{
+				Field: "status",
+				Local: statusString(exec.failed),
+				Node:  statusString(nodeFailed),
+			}, nil
+		}
+	}
+
+	if receipt.GasUsed() != nil && receipt.GasUsed().ToInt().Uint64() != exec.gasUsed {
+		return &Divergence{
+			Field: "gas used",
+			Local: strconv.FormatUint(exec.gasUsed, 10),
+			Node:  receipt.GasUsed().ToInt().String(),
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// pathTrace is a call of the local call tree together with its location.
+type pathTrace struct {
+	path  string
+	trace ethereum.Trace
+}
+
+// callPaths flattens the call tree in execution order, which is the order
+// the node reports the calls in.
+func callPaths(trace *geth.CallTrace, path string) []pathTrace {
+	if path == "" {
+		path = "0"
+	}
+
+	calls := []pathTrace{{path: path, trace: trace}}
+	for i := range trace.ValueCalls {
+		calls = append(calls, callPaths(&trace.ValueCalls[i], path+"/"+strconv.Itoa(i))...)
+	}
+
+	return calls
+}
+
+// nodePaths locates the calls of the node's call trace like callPaths does,
+// from the call tree of geth or the trace addresses of parity. Calls of other
+// traces are located by their position only.
+func nodePaths(traces ethereum.CallTraces) []pathTrace {
+	switch traces := traces.(type) {
+	case *geth.CallTrace:
+		return callPaths(traces, "")
+	case *parity.TraceResult:
+		var calls []pathTrace
+		for _, trace := range traces.CallTrace {
+			path := "0"
+			for _, index := range trace.ValueTraceAddress {
+				path += "/" + strconv.Itoa(index)
+			}
+			calls = append(calls, pathTrace{path: path, trace: trace})
+		}
+		return calls
+	}
+
+	var calls []pathTrace
+	for i, trace := range traces.Traces() {
+		calls = append(calls, pathTrace{path: fmt.Sprintf("#%d", i), trace: trace})
+	}
+
+	return calls
+}
+
+func compareCalls(local, node []pathTrace) *Divergence {
+	for i, call := range local {
+		if i >= len(node) {
+			return &Divergence{
+				Path:  call.path,
+				Field: "call",
+				Local: call.trace.Type(),
+				Node:  "none",
+			}
+		}
+
+		nodeCall := node[i].trace
+		if !strings.EqualFold(call.trace.Type(), nodeCall.Type()) {
+			return &Divergence{call.path, "type", call.trace.Type(), nodeCall.Type()}
+		}
+		if call.trace.From() != nodeCall.From() {
+			return &Divergence{call.path, "from", call.trace.From().String(), nodeCall.From().String()}
+		}
+		if call.trace.To() != nodeCall.To() {
+			return &Divergence{call.path, "to", call.trace.To().String(), nodeCall.To().String()}
+		}
+		if bigValue(call.trace.Value()).Cmp(bigValue(nodeCall.Value())) != 0 {
+			return &Divergence{call.path, "value", bigValue(call.trace.Value()).String(), bigValue(nodeCall.Value()).String()}
+		}
+		if call.trace.Input().String() != nodeCall.Input().String() {
+			return &Divergence{call.path, "input", call.trace.Input().String(), nodeCall.Input().String()}
+		}
+
+		// Nodes word their errors differently, so only the failure itself is
+		// compared.
+		if (call.trace.Error() == "") != (nodeCall.Error() == "") {
+			return &Divergence{call.path, "error", errorString(call.trace.Error()), errorString(nodeCall.Error())}
+		}
+		if call.trace.Error() == "" && call.trace.Output().String() != nodeCall.Output().String() {
+			return &Divergence{call.path, "output", call.trace.Output().String(), nodeCall.Output().String()}
+		}
+
+		// Gas used by the top level call is reported differently by every node,
+		// the transaction total is compared against the receipt instead.
+		if i > 0 && call.trace.GasUsed() != nil && nodeCall.GasUsed() != nil &&
+			*call.trace.GasUsed() != *nodeCall.GasUsed() {
+			return &Divergence{call.path, "gas used", call.trace.GasUsed().String(), nodeCall.GasUsed().String()}
+		}
+	}
+
+	if len(node) > len(local) {
+		return &Divergence{
+			Path:  node[len(local)].path,
+			Field: "call",
+			Local: "none",
+			Node:  node[len(local)].trace.Type(),
+		}
+	}
+
+	return nil
+}
+
+func compareLogs(exec *execution, node []ethereum.Log) *Divergence {
+	if len(exec.logs) != len(node) {
+		return &Divergence{
+			Field: "log count",
+			Local: strconv.Itoa(len(exec.logs)),
+			Node:  strconv.Itoa(len(node)),
+		}
+	}
+
+	for i, log := range exec.logs {
+		field := fmt.Sprintf("log %d ", i)
+		if !strings.EqualFold(log.Address.String(), node[i].Address()) {
+			return &Divergence{Field: field + "address", Local: log.Address.String(), Node: node[i].Address()}
+		}
+
+		var topics []string
+		for _, topic := range log.Topics {
+			topics = append(topics, topic.String())
+		}
+		if !strings.EqualFold(strings.Join(topics, ","), strings.Join(node[i].Topics(), ",")) {
+			return &Divergence{
+				Field: field + "topics",
+				Local: strings.Join(topics, ","),
+				Node:  strings.Join(node[i].Topics(), ","),
+			}
+		}
+
+		data := hexutil.Bytes(log.Data).String()
+		if !strings.EqualFold(data, node[i].Data()) {
+			return &Divergence{Field: field + "data", Local: data, Node: node[i].Data()}
+		}
+	}
+
+	return nil
+}
+
+func bigValue(value *hexutil.Big) *big.Int {
+	if value == nil {
+		return new(big.Int)
+	}
+
+	return value.ToInt()
+}
+
+func errorString(err string) string {
+	if err == "" {
+		return "none"
+	}
+
+	return strconv.Quote(err)
+}
+
+func statusString(failed bool) string {
+	if failed {
+		return "failed"
+	}
+
+	return "success"
+}
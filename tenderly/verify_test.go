@@ -0,0 +1,157 @@
+package tenderly
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/tenderly/tenderly-trace/ethereum"
+	"github.com/tenderly/tenderly-trace/ethereum/client"
+	"github.com/tenderly/tenderly-trace/ethereum/core/state"
+	"github.com/tenderly/tenderly-trace/ethereum/geth"
+	"github.com/tenderly/tenderly-trace/ethereum/parity"
+)
+
+var (
+	testSender   = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	testToken    = common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	testReceiver = common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	testTransfer = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+)
+
+// revertedSubcall is a call whose first subcall reverted, followed by one
+// which succeeded.
+func revertedSubcall(revertError string, revertOutput string) *geth.CallTrace {
+	return &geth.CallTrace{
+		ValueType:  "CALL",
+		ValueFrom:  testSender,
+		ValueTo:    testToken,
+		ValueInput: hexutil.MustDecode("0x01"),
+		ValueCalls: []geth.CallTrace{
+			{
+				ValueType:   "CALL",
+				ValueFrom:   testToken,
+				ValueTo:     testReceiver,
+				ValueInput:  hexutil.MustDecode("0x02"),
+				ValueOutput: hexutil.MustDecode(revertOutput),
+				ValueError:  revertError,
+			},
+			{
+				ValueType:   "CALL",
+				ValueFrom:   testToken,
+				ValueTo:     testReceiver,
+				ValueInput:  hexutil.MustDecode("0x03"),
+				ValueOutput: hexutil.MustDecode("0x04"),
+			},
+		},
+	}
+}
+
+// execute replays the logs of revertedSubcall on the state, the reverted
+// subcall emitting one of them.
+func execute() *execution {
+	db := state.New(client.Client{}, 1, nil)
+
+	db.AddLog(&types.Log{Address: testToken, Topics: []common.Hash{testTransfer}, Data: []byte{0x01}})
+
+	snapshot := db.Snapshot()
+	db.AddLog(&types.Log{Address: testReceiver, Topics: []common.Hash{testTransfer}, Data: []byte{0x02}})
+	db.RevertToSnapshot(snapshot)
+
+	db.AddLog(&types.Log{Address: testReceiver, Topics: []common.Hash{testTransfer}, Data: []byte{0x03}})
+
+	return &execution{logs: db.Logs()}
+}
+
+func nodeLogs(data ...string) []ethereum.Log {
+	var logs []ethereum.Log
+	for i, d := range data {
+		address := testToken
+		if i > 0 {
+			address = testReceiver
+		}
+
+		logs = append(logs, &geth.Log{
+			ValueAddress: address.Hex(),
+			ValueTopics:  []string{testTransfer.Hex()},
+			ValueData:    d,
+		})
+	}
+
+	return logs
+}
+
+func TestVerifyRevertedSubcall(t *testing.T) {
+	// Nodes word the error differently and report the revert data as the
+	// output, or no output at all.
+	local := callPaths(revertedSubcall("execution reverted", "0x08c379a0"), "")
+	node := nodePaths(revertedSubcall("Reverted", "0x"))
+	if divergence := compareCalls(local, node); divergence != nil {
+		t.Errorf("unexpected divergence: %s", divergence)
+	}
+
+	if divergence := compareLogs(execute(), nodeLogs("0x01", "0x03")); divergence != nil {
+		t.Errorf("unexpected divergence: %s", divergence)
+	}
+}
+
+func TestVerifyRevertedSubcallDivergence(t *testing.T) {
+	local := callPaths(revertedSubcall("", "0x05"), "")
+	node := nodePaths(revertedSubcall("Reverted", "0x"))
+
+	expected := Divergence{Path: "0/0", Field: "error", Local: "none", Node: `"Reverted"`}
+	if divergence := compareCalls(local, node); divergence == nil || *divergence != expected {
+		t.Errorf("expected divergence %s, got %v", &expected, divergence)
+	}
+
+	// The log emitted by the reverted subcall is reported by the node only
+	// if it didn't revert.
+	expected = Divergence{Field: "log count", Local: "2", Node: "3"}
+	if divergence := compareLogs(execute(), nodeLogs("0x01", "0x02", "0x03")); divergence == nil || *divergence != expected {
+		t.Errorf("expected divergence %s, got %v", &expected, divergence)
+	}
+}
+
+func TestVerifyExtraNodeCall(t *testing.T) {
+	local := revertedSubcall("execution reverted", "0x")
+	node := revertedSubcall("Reverted", "0x")
+	node.ValueCalls[1].ValueCalls = []geth.CallTrace{
+		{
+			ValueType:  "STATICCALL",
+			ValueFrom:  testReceiver,
+			ValueTo:    testToken,
+			ValueInput: hexutil.MustDecode("0x05"),
+		},
+	}
+
+	expected := Divergence{Path: "0/1/0", Field: "call", Local: "none", Node: "STATICCALL"}
+	if divergence := compareCalls(callPaths(local, ""), nodePaths(node)); divergence == nil || *divergence != expected {
+		t.Errorf("expected divergence %s, got %v", &expected, divergence)
+	}
+
+	// Parity locates the same call by its trace address and reports its type
+	// in lower case.
+	traces := &parity.TraceResult{}
+	for i, address := range [][]int{{}, {0}, {1}, {1, 0}} {
+		call := node.Traces()[i]
+		traces.CallTrace = append(traces.CallTrace, &parity.Trace{
+			ValueAction: parity.Action{
+				CallType: strings.ToLower(call.Type()),
+				From:     call.From(),
+				To:       call.To(),
+				Input:    call.Input(),
+			},
+			ValueResult:       parity.Result{Output: call.Output()},
+			ValueError:        call.Error(),
+			ValueTraceAddress: address,
+			ValueType:         "call",
+		})
+	}
+
+	expected.Node = "staticcall"
+	if divergence := compareCalls(callPaths(local, ""), nodePaths(traces)); divergence == nil || *divergence != expected {
+		t.Errorf("expected divergence %s, got %v", &expected, divergence)
+	}
+}
@@ -0,0 +1,50 @@
+package tenderly
+
+import (
+	"fmt"
+
+	"github.com/tenderly/tenderly-trace/ethereum"
+	"github.com/tenderly/tenderly-trace/source"
+)
+
+// NewBlocks follows the chain and reports the number of every new block. Nodes
+// without subscriptions, or all of them when forcePoll is set, are polled.
+func (t Tenderly) NewBlocks(forcePoll bool) (chan int64, error) {
+	blocks, err := t.client.Subscribe(forcePoll)
+	if err != nil {
+		return nil, fmt.Errorf("failed following new blocks, err: %s", err)
+	}
+
+	return blocks, nil
+}
+
+// BlockTransactions returns the hashes of the transactions of the block which
+// call contracts of the given source, or of all of them when all is set.
+func (t Tenderly) BlockTransactions(number int64, cs source.Source, all bool) ([]string, error) {
+	block, err := t.client.GetBlock(number)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching block %d, err: %s", number, err)
+	}
+
+	contracts := cs.GetSource()
+	var hashes []string
+	for _, tx := range block.Transactions() {
+		if all {
+			hashes = append(hashes, tx.Hash().String())
+			continue
+		}
+		if tx.To() == nil {
+			continue
+		}
+
+		code, err := t.client.GetCode(tx.To().String(), ethereum.Number(number))
+		if err != nil {
+			return nil, fmt.Errorf("failed fetching code of %s, err: %s", tx.To().String(), err)
+		}
+		if contracts.GetContract(tx.To().String(), *code) != nil {
+			hashes = append(hashes, tx.Hash().String())
+		}
+	}
+
+	return hashes, nil
+}